@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationProfileSpec defines the desired state of ApplicationProfile.
+type ApplicationProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationProfileParameters `json:"forProvider"`
+}
+
+// ApplicationProfileParameters are the configurable fields of
+// ApplicationProfile (fvAp).
+type ApplicationProfileParameters struct {
+	// Name of the application profile, the object is created as
+	// uni/tn-<tenant>/ap-<name>.
+	Name string `json:"name"`
+
+	// Tenant the application profile belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the application profile.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Prio is the QoS class of the application profile.
+	// +kubebuilder:validation:Enum=unspecified;level1;level2;level3;level4;level5;level6
+	// +optional
+	Prio string `json:"prio,omitempty"`
+
+	// MonitoringPolicy is the name of the monitoring policy (fvRsApMonPol).
+	// +optional
+	MonitoringPolicy string `json:"monitoringPolicy,omitempty"`
+}
+
+// ApplicationProfileStatus defines the observed state of ApplicationProfile.
+type ApplicationProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ApplicationProfile is the Schema for the ApplicationProfile API.
+type ApplicationProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationProfileSpec   `json:"spec"`
+	Status ApplicationProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationProfileList contains a list of ApplicationProfile objects.
+type ApplicationProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationProfile `json:"items"`
+}
@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AttachableEntityProfileSpec defines the desired state of AttachableEntityProfile.
+type AttachableEntityProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AttachableEntityProfileParameters `json:"forProvider"`
+}
+
+// AttachableEntityProfileParameters are the configurable fields of
+// AttachableEntityProfile (infraAttEntityP).
+type AttachableEntityProfileParameters struct {
+	// Name of the AAEP, the object is created as uni/infra/attentp-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the AAEP.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Domains are the DNs of the domains the AAEP gives access to
+	// (infraRsDomP), for example uni/phys-servers. Domains that are not
+	// listed are removed.
+	// +optional
+	Domains []string `json:"domains,omitempty"`
+}
+
+// AttachableEntityProfileStatus defines the observed state of AttachableEntityProfile.
+type AttachableEntityProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AttachableEntityProfile is the Schema for the AttachableEntityProfile API.
+type AttachableEntityProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AttachableEntityProfileSpec   `json:"spec"`
+	Status AttachableEntityProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AttachableEntityProfileList contains a list of AttachableEntityProfile objects.
+type AttachableEntityProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AttachableEntityProfile `json:"items"`
+}
@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BridgeDomainSpec defines the desired state of BridgeDomain.
+type BridgeDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BridgeDomainParameters `json:"forProvider"`
+}
+
+// BridgeDomainParameters are the configurable fields of BridgeDomain (fvBD).
+// Optional fields that are left empty keep the APIC default.
+type BridgeDomainParameters struct {
+	// Name of the bridge domain, the object is created as uni/tn-<tenant>/BD-<name>.
+	Name string `json:"name"`
+
+	// Tenant the bridge domain belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the bridge domain.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Vrf is the name of the VRF the bridge domain is associated with (fvRsCtx).
+	Vrf string `json:"vrf"`
+
+	// UnicastRoute enables IP routing on the bridge domain.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	UnicastRoute string `json:"unicastRoute,omitempty"`
+
+	// ArpFlood enables flooding of ARP requests.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	ArpFlood string `json:"arpFlood,omitempty"`
+
+	// UnkMacUcastAct is the forwarding mode for unknown unicast traffic.
+	// +kubebuilder:validation:Enum=flood;proxy
+	// +optional
+	UnkMacUcastAct string `json:"unkMacUcastAct,omitempty"`
+
+	// UnkMcastAct is the forwarding mode for unknown L3 multicast traffic.
+	// +kubebuilder:validation:Enum=flood;opt-flood
+	// +optional
+	UnkMcastAct string `json:"unkMcastAct,omitempty"`
+
+	// MultiDstPktAct is the forwarding mode for multi-destination traffic.
+	// +kubebuilder:validation:Enum=bd-flood;encap-flood;drop
+	// +optional
+	MultiDstPktAct string `json:"multiDstPktAct,omitempty"`
+
+	// LimitIPLearnToSubnets restricts endpoint IP learning to the subnets
+	// of the bridge domain.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	LimitIPLearnToSubnets string `json:"limitIpLearnToSubnets,omitempty"`
+
+	// L3Outs are the names of the L3Outs the bridge domain is advertised
+	// through (fvRsBDToOut).
+	// +optional
+	L3Outs []string `json:"l3Outs,omitempty"`
+}
+
+// BridgeDomainStatus defines the observed state of BridgeDomain.
+type BridgeDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BridgeDomain is the Schema for the BridgeDomain API.
+type BridgeDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgeDomainSpec   `json:"spec"`
+	Status BridgeDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BridgeDomainList contains a list of BridgeDomain objects.
+type BridgeDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BridgeDomain `json:"items"`
+}
@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BridgeDomainSubnetSpec defines the desired state of BridgeDomainSubnet.
+type BridgeDomainSubnetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BridgeDomainSubnetParameters `json:"forProvider"`
+}
+
+// BridgeDomainSubnetParameters are the configurable fields of
+// BridgeDomainSubnet (fvSubnet). Optional fields that are left empty keep the
+// APIC default.
+type BridgeDomainSubnetParameters struct {
+	// Tenant the bridge domain belongs to.
+	Tenant string `json:"tenant"`
+
+	// Bd is the name of the bridge domain the subnet is created in.
+	Bd string `json:"bd"`
+
+	// IP is the gateway address and prefix length, e.g. 10.0.0.1/24. The
+	// object is created as uni/tn-<tenant>/BD-<bd>/subnet-[<ip>].
+	IP string `json:"ip"`
+
+	// Desc is the description of the subnet.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Scope controls where the subnet is advertised. private and public are
+	// mutually exclusive, shared can be combined with either. An empty list
+	// resets the subnet to the APIC default, private.
+	// +optional
+	Scope []string `json:"scope,omitempty"`
+
+	// Preferred marks the subnet as the preferred (primary) gateway address.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	Preferred string `json:"preferred,omitempty"`
+
+	// Virtual marks the gateway as a virtual IP, used for stretched bridge
+	// domains in multi-pod or multi-site fabrics.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	Virtual string `json:"virtual,omitempty"`
+
+	// NoDefaultGateway disables the default gateway function of the subnet.
+	// Other ctrl flags set on the APIC, such as querier, are kept.
+	// +optional
+	NoDefaultGateway bool `json:"noDefaultGateway,omitempty"`
+
+	// NdRaPrefixPolicy is the name of the ND RA prefix policy (fvRsNdPfxPol).
+	// +optional
+	NdRaPrefixPolicy string `json:"ndRaPrefixPolicy,omitempty"`
+}
+
+// BridgeDomainSubnetStatus defines the observed state of BridgeDomainSubnet.
+type BridgeDomainSubnetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BridgeDomainSubnet is the Schema for the BridgeDomainSubnet API.
+type BridgeDomainSubnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgeDomainSubnetSpec   `json:"spec"`
+	Status BridgeDomainSubnetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BridgeDomainSubnetList contains a list of BridgeDomainSubnet objects.
+type BridgeDomainSubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BridgeDomainSubnet `json:"items"`
+}
@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CDPPolicySpec defines the desired state of CDPPolicy.
+type CDPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CDPPolicyParameters `json:"forProvider"`
+}
+
+// CDPPolicyParameters are the configurable fields of CDPPolicy (cdpIfPol).
+// Optional fields that are left empty keep the APIC default.
+type CDPPolicyParameters struct {
+	// Name of the CDP policy, the object is created as
+	// uni/infra/cdpIfP-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the CDP policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// AdminState enables or disables CDP on the interface.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	AdminState string `json:"adminState,omitempty"`
+}
+
+// CDPPolicyStatus defines the observed state of CDPPolicy.
+type CDPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// CDPPolicy is the Schema for the CDPPolicy API.
+type CDPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CDPPolicySpec   `json:"spec"`
+	Status CDPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CDPPolicyList contains a list of CDPPolicy objects.
+type CDPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CDPPolicy `json:"items"`
+}
@@ -0,0 +1,40 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TypeSelectorsValid indicates whether the selectors of a switch or
+// interface profile are free of overlapping ranges.
+const TypeSelectorsValid xpv1.ConditionType = "SelectorsValid"
+
+// Reasons for the SelectorsValid condition.
+const (
+	ReasonSelectorsValid       xpv1.ConditionReason = "NoOverlap"
+	ReasonOverlappingSelectors xpv1.ConditionReason = "OverlappingSelectors"
+)
+
+// SelectorsValid returns a condition that indicates the selectors of the
+// profile do not overlap.
+func SelectorsValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSelectorsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSelectorsValid,
+	}
+}
+
+// SelectorsOverlap returns a condition that indicates the selectors of the
+// profile overlap. The message lists the overlapping ranges.
+func SelectorsOverlap(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSelectorsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOverlappingSelectors,
+		Message:            msg,
+	}
+}
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ContractSpec defines the desired state of Contract.
+type ContractSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ContractParameters `json:"forProvider"`
+}
+
+// ContractParameters are the configurable fields of Contract (vzBrCP).
+// Optional fields that are left empty keep the APIC default.
+type ContractParameters struct {
+	// Name of the contract, the object is created as uni/tn-<tenant>/brc-<name>.
+	Name string `json:"name"`
+
+	// Tenant the contract belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the contract.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Scope limits the EPGs that can communicate through the contract.
+	// +kubebuilder:validation:Enum=context;tenant;global;application-profile
+	// +optional
+	Scope string `json:"scope,omitempty"`
+
+	// Prio is the QoS class of the contract.
+	// +kubebuilder:validation:Enum=unspecified;level1;level2;level3;level4;level5;level6
+	// +optional
+	Prio string `json:"prio,omitempty"`
+
+	// Subjects of the contract (vzSubj). Subjects that are not listed are
+	// removed from the contract.
+	// +optional
+	Subjects []ContractSubject `json:"subjects,omitempty"`
+}
+
+// ContractSubject is a subject (vzSubj) of a contract.
+type ContractSubject struct {
+	// Name of the subject.
+	Name string `json:"name"`
+
+	// Desc is the description of the subject.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// ApplyBothDirections applies the filters to consumer-to-provider and
+	// provider-to-consumer traffic. Defaults to yes. With no, the filters are
+	// attached to the in and out terms of the subject instead.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	ApplyBothDirections string `json:"applyBothDirections,omitempty"`
+
+	// ReverseFilterPorts swaps source and destination ports for the return
+	// traffic. Only valid when ApplyBothDirections is yes.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	ReverseFilterPorts string `json:"reverseFilterPorts,omitempty"`
+
+	// Filters are the names of the filters attached to the subject
+	// (vzRsSubjFiltAtt).
+	// +optional
+	Filters []string `json:"filters,omitempty"`
+}
+
+// ContractStatus defines the observed state of Contract.
+type ContractStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Contract is the Schema for the Contract API.
+type Contract struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContractSpec   `json:"spec"`
+	Status ContractStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContractList contains a list of Contract objects.
+type ContractList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Contract `json:"items"`
+}
+
+// ContractRelations are the contracts an endpoint group provides, consumes or
+// is protected by. Relations that are not listed are removed from the APIC.
+type ContractRelations struct {
+	// Provided are the names of the contracts provided (fvRsProv).
+	// +optional
+	Provided []string `json:"provided,omitempty"`
+
+	// Consumed are the names of the contracts consumed (fvRsCons).
+	// +optional
+	Consumed []string `json:"consumed,omitempty"`
+
+	// ConsumedInterfaces are the names of the contract interfaces consumed
+	// (fvRsConsIf).
+	// +optional
+	ConsumedInterfaces []string `json:"consumedInterfaces,omitempty"`
+
+	// Taboo are the names of the taboo contracts protecting the group
+	// (fvRsProtBy).
+	// +optional
+	Taboo []string `json:"taboo,omitempty"`
+}
@@ -0,0 +1,4 @@
+// Package v1alpha1 contains the v1alpha1 group of the Cisco ACI provider API.
+// +kubebuilder:object:generate=true
+// +groupName=ciscoaci.crossplane.io
+package v1alpha1
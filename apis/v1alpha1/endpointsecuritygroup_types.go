@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EndpointSecurityGroupSpec defines the desired state of EndpointSecurityGroup.
+type EndpointSecurityGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointSecurityGroupParameters `json:"forProvider"`
+}
+
+// EndpointSecurityGroupParameters are the configurable fields of
+// EndpointSecurityGroup (fvESg). Selectors that are not listed are removed.
+type EndpointSecurityGroupParameters struct {
+	// Name of the ESG, the object is created as
+	// uni/tn-<tenant>/ap-<appProfile>/esg-<name>.
+	Name string `json:"name"`
+
+	// Tenant the ESG belongs to.
+	Tenant string `json:"tenant"`
+
+	// AppProfile is the name of the application profile of the ESG.
+	AppProfile string `json:"appProfile"`
+
+	// Desc is the description of the ESG.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Vrf is the name of the VRF the ESG is scoped to (fvRsScope).
+	Vrf string `json:"vrf"`
+
+	// EPGSelectors are the DNs of the EPGs whose endpoints belong to the
+	// ESG (fvEPgSelector).
+	// +optional
+	EPGSelectors []string `json:"epgSelectors,omitempty"`
+
+	// IPSubnetSelectors are the IP subnets whose endpoints belong to the
+	// ESG (fvEPSelector), for example 10.0.0.0/24.
+	// +optional
+	IPSubnetSelectors []string `json:"ipSubnetSelectors,omitempty"`
+
+	// TagSelectors match endpoints by their tags (fvTagSelector).
+	// +optional
+	TagSelectors []TagSelector `json:"tagSelectors,omitempty"`
+
+	// Contracts the ESG provides or consumes. Taboo contracts are not
+	// supported on ESGs.
+	// +optional
+	Contracts ContractRelations `json:"contracts,omitempty"`
+}
+
+// TagSelector matches endpoints by a tag key and value.
+type TagSelector struct {
+	// Key is the tag key to match.
+	Key string `json:"key"`
+
+	// Value is the tag value to match.
+	Value string `json:"value"`
+
+	// Operator is used to compare the tag value.
+	// +kubebuilder:validation:Enum=equals;contains;regex
+	// +optional
+	Operator string `json:"operator,omitempty"`
+}
+
+// EndpointSecurityGroupStatus defines the observed state of EndpointSecurityGroup.
+type EndpointSecurityGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// EndpointSecurityGroup is the Schema for the EndpointSecurityGroup API.
+type EndpointSecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSecurityGroupSpec   `json:"spec"`
+	Status EndpointSecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointSecurityGroupList contains a list of EndpointSecurityGroup objects.
+type EndpointSecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EndpointSecurityGroup `json:"items"`
+}
@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalEPGSpec defines the desired state of ExternalEPG.
+type ExternalEPGSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ExternalEPGParameters `json:"forProvider"`
+}
+
+// ExternalEPGParameters are the configurable fields of ExternalEPG
+// (l3extInstP).
+type ExternalEPGParameters struct {
+	// Name of the external EPG, the object is created as
+	// uni/tn-<tenant>/out-<l3Out>/instP-<name>.
+	Name string `json:"name"`
+
+	// Tenant the L3Out belongs to.
+	Tenant string `json:"tenant"`
+
+	// L3Out is the name of the L3Out the external EPG belongs to.
+	L3Out string `json:"l3Out"`
+
+	// Desc is the description of the external EPG.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Subnets classify external prefixes into the EPG (l3extSubnet).
+	// Subnets that are not listed are removed.
+	// +optional
+	Subnets []ExternalEPGSubnet `json:"subnets,omitempty"`
+
+	// Contracts the external EPG provides, consumes or is protected by.
+	// +optional
+	Contracts ContractRelations `json:"contracts,omitempty"`
+}
+
+// ExternalEPGSubnet is a prefix classified into an external EPG.
+type ExternalEPGSubnet struct {
+	// IP is the prefix, for example 0.0.0.0/0.
+	IP string `json:"ip"`
+
+	// Scope flags of the subnet: import-security, shared-security,
+	// import-rtctrl, export-rtctrl and shared-rtctrl. An empty list resets
+	// the subnet to the APIC default, import-security.
+	// +optional
+	Scope []string `json:"scope,omitempty"`
+
+	// Aggregate flags of the subnet: import-rtctrl, export-rtctrl and
+	// shared-rtctrl. An empty list clears all aggregate flags.
+	// +optional
+	Aggregate []string `json:"aggregate,omitempty"`
+}
+
+// ExternalEPGStatus defines the observed state of ExternalEPG.
+type ExternalEPGStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ExternalEPG is the Schema for the ExternalEPG API.
+type ExternalEPG struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ExternalEPGSpec   `json:"spec"`
+	Status ExternalEPGStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ExternalEPGList contains a list of ExternalEPG objects.
+type ExternalEPGList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalEPG `json:"items"`
+}
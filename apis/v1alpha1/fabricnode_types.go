@@ -0,0 +1,89 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FabricNodeSpec defines the desired state of FabricNode.
+type FabricNodeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FabricNodeParameters `json:"forProvider"`
+}
+
+// FabricNodeParameters are the configurable fields of FabricNode, a node
+// registration (fabricNodeIdentP) under uni/controller/nodeidentpol.
+type FabricNodeParameters struct {
+	// SerialNumber of the switch, the object is created as
+	// uni/controller/nodeidentpol/nodep-<serialNumber>.
+	SerialNumber string `json:"serialNumber"`
+
+	// NodeID is the node ID assigned to the switch.
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=4000
+	NodeID int `json:"nodeId"`
+
+	// Name is the host name of the switch.
+	Name string `json:"name"`
+
+	// Role of the switch in the fabric.
+	// +kubebuilder:validation:Enum=leaf;spine
+	Role string `json:"role"`
+
+	// PodID is the pod the switch belongs to. Defaults to 1.
+	// +optional
+	PodID string `json:"podId,omitempty"`
+
+	// NodeType distinguishes remote leaves and tier-2 leaves from regular
+	// switches.
+	// +kubebuilder:validation:Enum=unspecified;remote-leaf-wan;tier-2-leaf
+	// +optional
+	NodeType string `json:"nodeType,omitempty"`
+}
+
+// FabricNodeObservation are the observable fields of FabricNode.
+type FabricNodeObservation struct {
+	// FabricState is the state of the node in the fabric as reported by
+	// fabricNode, e.g. discovering, active or inactive.
+	FabricState string `json:"fabricState,omitempty"`
+
+	// SystemState is the state of the switch as reported by topSystem,
+	// e.g. in-service.
+	SystemState string `json:"systemState,omitempty"`
+
+	// Address is the TEP address of the switch.
+	Address string `json:"address,omitempty"`
+
+	// Model is the hardware model of the switch.
+	Model string `json:"model,omitempty"`
+
+	// Version is the software version running on the switch.
+	Version string `json:"version,omitempty"`
+}
+
+// FabricNodeStatus defines the observed state of FabricNode.
+type FabricNodeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FabricNodeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FabricNode is the Schema for the FabricNode API.
+type FabricNode struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FabricNodeSpec   `json:"spec"`
+	Status FabricNodeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FabricNodeList contains a list of FabricNode objects.
+type FabricNodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FabricNode `json:"items"`
+}
@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FilterSpec defines the desired state of Filter.
+type FilterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FilterParameters `json:"forProvider"`
+}
+
+// FilterParameters are the configurable fields of Filter (vzFilter). The
+// entries of a filter are managed as separate FilterEntry resources.
+type FilterParameters struct {
+	// Name of the filter, the object is created as uni/tn-<tenant>/flt-<name>.
+	Name string `json:"name"`
+
+	// Tenant the filter belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the filter.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+}
+
+// FilterStatus defines the observed state of Filter.
+type FilterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Filter is the Schema for the Filter API.
+type Filter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FilterSpec   `json:"spec"`
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FilterList contains a list of Filter objects.
+type FilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Filter `json:"items"`
+}
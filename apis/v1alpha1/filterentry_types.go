@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FilterEntrySpec defines the desired state of FilterEntry.
+type FilterEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FilterEntryParameters `json:"forProvider"`
+}
+
+// FilterEntryParameters are the configurable fields of FilterEntry (vzEntry).
+// Optional fields that are left empty keep the APIC default.
+type FilterEntryParameters struct {
+	// Name of the entry, the object is created as
+	// uni/tn-<tenant>/flt-<filter>/e-<name>.
+	Name string `json:"name"`
+
+	// Tenant the filter belongs to.
+	Tenant string `json:"tenant"`
+
+	// Filter is the name of the filter the entry belongs to.
+	Filter string `json:"filter"`
+
+	// Desc is the description of the entry.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// EtherT is the ethertype to match.
+	// +kubebuilder:validation:Enum=unspecified;ipv4;ipv6;ip;arp;mpls_ucast;mac_security;fcoe;trill
+	// +optional
+	EtherT string `json:"etherT,omitempty"`
+
+	// Prot is the IP protocol to match, either a name such as tcp or udp or
+	// a protocol number between 0 and 255. Numbers of named protocols, such
+	// as 6 for tcp, are sent to the APIC as the name.
+	// +optional
+	Prot string `json:"prot,omitempty"`
+
+	// SFromPort is the start of the source port range, either a port number
+	// or an ACI named port (ftpData, smtp, dns, http, pop3, https, rtsp).
+	// +optional
+	SFromPort string `json:"sFromPort,omitempty"`
+
+	// SToPort is the end of the source port range.
+	// +optional
+	SToPort string `json:"sToPort,omitempty"`
+
+	// DFromPort is the start of the destination port range.
+	// +optional
+	DFromPort string `json:"dFromPort,omitempty"`
+
+	// DToPort is the end of the destination port range.
+	// +optional
+	DToPort string `json:"dToPort,omitempty"`
+
+	// TCPRules are the TCP flags to match (est, syn, ack, fin, rst).
+	// +optional
+	TCPRules []string `json:"tcpRules,omitempty"`
+
+	// Stateful enables stateful matching of TCP traffic.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	Stateful string `json:"stateful,omitempty"`
+
+	// ApplyToFrag matches IP fragments only. Cannot be combined with ports.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	ApplyToFrag string `json:"applyToFrag,omitempty"`
+}
+
+// FilterEntryStatus defines the observed state of FilterEntry.
+type FilterEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FilterEntry is the Schema for the FilterEntry API.
+type FilterEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FilterEntrySpec   `json:"spec"`
+	Status FilterEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FilterEntryList contains a list of FilterEntry objects.
+type FilterEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FilterEntry `json:"items"`
+}
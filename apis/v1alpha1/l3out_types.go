@@ -0,0 +1,124 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// L3OutSpec defines the desired state of L3Out.
+type L3OutSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       L3OutParameters `json:"forProvider"`
+}
+
+// L3OutParameters are the configurable fields of L3Out (l3extOut).
+type L3OutParameters struct {
+	// Name of the L3Out, the object is created as uni/tn-<tenant>/out-<name>.
+	Name string `json:"name"`
+
+	// Tenant the L3Out belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the L3Out.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Vrf is the name of the VRF the L3Out belongs to (l3extRsEctx).
+	Vrf string `json:"vrf"`
+
+	// L3Domain is the DN of the L3 domain (l3extRsL3DomAtt), for example
+	// uni/l3dom-wan.
+	L3Domain string `json:"l3Domain"`
+
+	// NodeProfiles are the logical node profiles of the L3Out
+	// (l3extLNodeP). Profiles that are not listed are removed.
+	// +optional
+	NodeProfiles []L3OutNodeProfile `json:"nodeProfiles,omitempty"`
+}
+
+// L3OutNodeProfile is a logical node profile of an L3Out.
+type L3OutNodeProfile struct {
+	// Name of the node profile.
+	Name string `json:"name"`
+
+	// Nodes are the border leaves of the profile (l3extRsNodeL3OutAtt).
+	// +optional
+	Nodes []L3OutNode `json:"nodes,omitempty"`
+
+	// InterfaceProfiles are the logical interface profiles of the node
+	// profile (l3extLIfP).
+	// +optional
+	InterfaceProfiles []L3OutInterfaceProfile `json:"interfaceProfiles,omitempty"`
+}
+
+// L3OutNode is a border leaf of a node profile.
+type L3OutNode struct {
+	// Node is the DN of the node, for example topology/pod-1/node-101.
+	Node string `json:"node"`
+
+	// RouterID is the router ID of the node in the VRF.
+	RouterID string `json:"routerId"`
+
+	// RouterIDLoopback creates a loopback interface with the router ID.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	RouterIDLoopback string `json:"routerIdLoopback,omitempty"`
+}
+
+// L3OutInterfaceProfile is a logical interface profile of a node profile.
+type L3OutInterfaceProfile struct {
+	// Name of the interface profile.
+	Name string `json:"name"`
+
+	// Paths are the interfaces of the profile (l3extRsPathL3OutAtt).
+	// +optional
+	Paths []L3OutPath `json:"paths,omitempty"`
+}
+
+// L3OutPath is a routed interface, routed sub-interface or SVI of an L3Out.
+type L3OutPath struct {
+	// Path is the DN of the fabric path, for example
+	// topology/pod-1/paths-101/pathep-[eth1/10].
+	Path string `json:"path"`
+
+	// Type is the interface type.
+	// +kubebuilder:validation:Enum=l3-port;sub-interface;ext-svi
+	Type string `json:"type"`
+
+	// Encap is the VLAN of sub-interfaces and SVIs, for example vlan-300.
+	// +optional
+	Encap string `json:"encap,omitempty"`
+
+	// Address is the IP address and prefix length of the interface.
+	Address string `json:"address"`
+
+	// Mtu of the interface, inherit uses the fabric default.
+	// +optional
+	Mtu string `json:"mtu,omitempty"`
+}
+
+// L3OutStatus defines the observed state of L3Out.
+type L3OutStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// L3Out is the Schema for the L3Out API.
+type L3Out struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   L3OutSpec   `json:"spec"`
+	Status L3OutStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// L3OutList contains a list of L3Out objects.
+type L3OutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []L3Out `json:"items"`
+}
@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LACPPolicySpec defines the desired state of LACPPolicy.
+type LACPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LACPPolicyParameters `json:"forProvider"`
+}
+
+// LACPPolicyParameters are the configurable fields of LACPPolicy
+// (lacpLagPol). Optional fields that are left empty keep the APIC
+// default.
+type LACPPolicyParameters struct {
+	// Name of the LACP policy, the object is created as
+	// uni/infra/lacplagp-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the LACP policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Mode is the port-channel mode.
+	// +kubebuilder:validation:Enum=off;active;passive;mac-pin;mac-pin-nicload;explicit-failover
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Control are the port-channel control flags, for example
+	// fast-sel-hot-stdby, graceful-conv, susp-individual, load-defer or
+	// symmetric-hash.
+	// +optional
+	Control []string `json:"control,omitempty"`
+
+	// MinLinks is the minimum number of active links of the port-channel.
+	// +optional
+	MinLinks string `json:"minLinks,omitempty"`
+
+	// MaxLinks is the maximum number of active links of the port-channel.
+	// +optional
+	MaxLinks string `json:"maxLinks,omitempty"`
+}
+
+// LACPPolicyStatus defines the observed state of LACPPolicy.
+type LACPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LACPPolicy is the Schema for the LACPPolicy API.
+type LACPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LACPPolicySpec   `json:"spec"`
+	Status LACPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LACPPolicyList contains a list of LACPPolicy objects.
+type LACPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LACPPolicy `json:"items"`
+}
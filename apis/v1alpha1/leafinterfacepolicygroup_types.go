@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LeafInterfacePolicyGroupSpec defines the desired state of LeafInterfacePolicyGroup.
+type LeafInterfacePolicyGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LeafInterfacePolicyGroupParameters `json:"forProvider"`
+}
+
+// LeafInterfacePolicyGroupParameters are the configurable fields of
+// LeafInterfacePolicyGroup. An access policy group is created as
+// infraAccPortGrp, a port-channel or vPC policy group as infraAccBndlGrp.
+// Policies that are left empty use the APIC default policy.
+type LeafInterfacePolicyGroupParameters struct {
+	// Name of the policy group, the object is created as
+	// uni/infra/funcprof/accportgrp-<name> for access and as
+	// uni/infra/funcprof/accbundle-<name> for pc and vpc.
+	Name string `json:"name"`
+
+	// Type selects the access, port-channel (lagT=link) or vPC (lagT=node)
+	// variant of the policy group. It cannot be changed after creation, as
+	// the variants are stored as different objects on the APIC.
+	// +kubebuilder:validation:Enum=access;pc;vpc
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type string `json:"type"`
+
+	// Desc is the description of the policy group.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// LinkLevelPolicy is the name of the link level policy (infraRsHIfPol).
+	// +optional
+	LinkLevelPolicy string `json:"linkLevelPolicy,omitempty"`
+
+	// CDPPolicy is the name of the CDP interface policy (infraRsCdpIfPol).
+	// +optional
+	CDPPolicy string `json:"cdpPolicy,omitempty"`
+
+	// LLDPPolicy is the name of the LLDP interface policy (infraRsLldpIfPol).
+	// +optional
+	LLDPPolicy string `json:"lldpPolicy,omitempty"`
+
+	// LACPPolicy is the name of the port-channel policy (infraRsLacpPol).
+	// Only valid for pc and vpc.
+	// +optional
+	LACPPolicy string `json:"lacpPolicy,omitempty"`
+
+	// MCPPolicy is the name of the MCP interface policy (infraRsMcpIfPol).
+	// +optional
+	MCPPolicy string `json:"mcpPolicy,omitempty"`
+
+	// StormControlPolicy is the name of the storm control interface policy
+	// (infraRsStormctrlIfPol).
+	// +optional
+	StormControlPolicy string `json:"stormControlPolicy,omitempty"`
+
+	// AttachableEntityProfile is the DN of the AAEP (infraRsAttEntP), for
+	// example uni/infra/attentp-servers.
+	// +optional
+	AttachableEntityProfile string `json:"attachableEntityProfile,omitempty"`
+}
+
+// LeafInterfacePolicyGroupStatus defines the observed state of LeafInterfacePolicyGroup.
+type LeafInterfacePolicyGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LeafInterfacePolicyGroup is the Schema for the LeafInterfacePolicyGroup API.
+type LeafInterfacePolicyGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LeafInterfacePolicyGroupSpec   `json:"spec"`
+	Status LeafInterfacePolicyGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LeafInterfacePolicyGroupList contains a list of LeafInterfacePolicyGroup objects.
+type LeafInterfacePolicyGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LeafInterfacePolicyGroup `json:"items"`
+}
@@ -0,0 +1,112 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LeafInterfaceProfileSpec defines the desired state of LeafInterfaceProfile.
+type LeafInterfaceProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LeafInterfaceProfileParameters `json:"forProvider"`
+}
+
+// LeafInterfaceProfileParameters are the configurable fields of
+// LeafInterfaceProfile (infraAccPortP).
+type LeafInterfaceProfileParameters struct {
+	// Name of the interface profile, the object is created as
+	// uni/infra/accportprof-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the interface profile.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Selectors select the interfaces of the profile (infraHPortS).
+	// Selectors that are not listed are removed. Port blocks must not
+	// overlap across selectors.
+	// +optional
+	Selectors []InterfaceSelector `json:"selectors,omitempty"`
+}
+
+// InterfaceSelector selects interfaces by port ranges and applies a policy
+// group to them.
+type InterfaceSelector struct {
+	// Name of the selector.
+	Name string `json:"name"`
+
+	// Desc is the description of the selector.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// PolicyGroup is the DN of the interface policy group applied to the
+	// selected ports (infraRsAccBaseGrp), for example
+	// uni/infra/funcprof/accportgrp-servers.
+	// +optional
+	PolicyGroup string `json:"policyGroup,omitempty"`
+
+	// PortBlocks are the port ranges of the selector. A block with sub-ports
+	// selects breakout ports (infraSubPortBlk), otherwise whole ports
+	// (infraPortBlk).
+	PortBlocks []PortBlock `json:"portBlocks"`
+}
+
+// PortBlock is a range of ports, optionally narrowed to breakout sub-ports.
+// The range runs from FromCard/FromPort to ToCard/ToPort, so 1/10-2/5
+// selects port 10 and up on card 1 and ports 1 to 5 on card 2.
+type PortBlock struct {
+	// Name of the block.
+	Name string `json:"name"`
+
+	// FromCard is the first card (module) of the range.
+	// +kubebuilder:validation:Minimum=1
+	FromCard int `json:"fromCard"`
+
+	// ToCard is the last card (module) of the range.
+	// +kubebuilder:validation:Minimum=1
+	ToCard int `json:"toCard"`
+
+	// FromPort is the first port of the range.
+	// +kubebuilder:validation:Minimum=1
+	FromPort int `json:"fromPort"`
+
+	// ToPort is the last port of the range.
+	// +kubebuilder:validation:Minimum=1
+	ToPort int `json:"toPort"`
+
+	// FromSubPort is the first breakout sub-port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FromSubPort int `json:"fromSubPort,omitempty"`
+
+	// ToSubPort is the last breakout sub-port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ToSubPort int `json:"toSubPort,omitempty"`
+}
+
+// LeafInterfaceProfileStatus defines the observed state of LeafInterfaceProfile.
+type LeafInterfaceProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LeafInterfaceProfile is the Schema for the LeafInterfaceProfile API.
+type LeafInterfaceProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LeafInterfaceProfileSpec   `json:"spec"`
+	Status LeafInterfaceProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LeafInterfaceProfileList contains a list of LeafInterfaceProfile objects.
+type LeafInterfaceProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LeafInterfaceProfile `json:"items"`
+}
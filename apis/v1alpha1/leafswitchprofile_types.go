@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LeafSwitchProfileSpec defines the desired state of LeafSwitchProfile.
+type LeafSwitchProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LeafSwitchProfileParameters `json:"forProvider"`
+}
+
+// LeafSwitchProfileParameters are the configurable fields of
+// LeafSwitchProfile (infraNodeP).
+type LeafSwitchProfileParameters struct {
+	// Name of the switch profile, the object is created as
+	// uni/infra/nprof-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the switch profile.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// LeafSelectors select the leaf switches of the profile (infraLeafS).
+	// Selectors that are not listed are removed. Node blocks must not
+	// overlap across selectors.
+	// +optional
+	LeafSelectors []LeafSelector `json:"leafSelectors,omitempty"`
+
+	// InterfaceProfiles are the DNs of the interface profiles applied to the
+	// selected switches (infraRsAccPortP), for example
+	// uni/infra/accportprof-servers.
+	// +optional
+	InterfaceProfiles []string `json:"interfaceProfiles,omitempty"`
+}
+
+// LeafSelector selects leaf switches by node ID ranges.
+type LeafSelector struct {
+	// Name of the selector.
+	Name string `json:"name"`
+
+	// NodeBlocks are the node ID ranges of the selector (infraNodeBlk).
+	NodeBlocks []NodeBlock `json:"nodeBlocks"`
+}
+
+// NodeBlock is a range of node IDs.
+type NodeBlock struct {
+	// Name of the block.
+	Name string `json:"name"`
+
+	// From is the first node ID of the range.
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=4000
+	From int `json:"from"`
+
+	// To is the last node ID of the range.
+	// +kubebuilder:validation:Minimum=101
+	// +kubebuilder:validation:Maximum=4000
+	To int `json:"to"`
+}
+
+// LeafSwitchProfileStatus defines the observed state of LeafSwitchProfile.
+type LeafSwitchProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LeafSwitchProfile is the Schema for the LeafSwitchProfile API.
+type LeafSwitchProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LeafSwitchProfileSpec   `json:"spec"`
+	Status LeafSwitchProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LeafSwitchProfileList contains a list of LeafSwitchProfile objects.
+type LeafSwitchProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LeafSwitchProfile `json:"items"`
+}
@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LinkLevelPolicySpec defines the desired state of LinkLevelPolicy.
+type LinkLevelPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LinkLevelPolicyParameters `json:"forProvider"`
+}
+
+// LinkLevelPolicyParameters are the configurable fields of LinkLevelPolicy
+// (fabricHIfPol). Optional fields that are left empty keep the APIC
+// default.
+type LinkLevelPolicyParameters struct {
+	// Name of the link level policy, the object is created as
+	// uni/infra/hintfpol-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the link level policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// AutoNeg controls auto negotiation of the link.
+	// +kubebuilder:validation:Enum=on;off;on-enforce
+	// +optional
+	AutoNeg string `json:"autoNeg,omitempty"`
+
+	// Speed of the link, inherit uses the speed of the transceiver.
+	// +kubebuilder:validation:Enum=inherit;"100M";"1G";"10G";"25G";"40G";"50G";"100G";"200G";"400G"
+	// +optional
+	Speed string `json:"speed,omitempty"`
+
+	// FECMode is the forward error correction mode of the link.
+	// +kubebuilder:validation:Enum=inherit;cl74-fc-fec;cl91-rs-fec;cons16-rs-fec;ieee-rs-fec;kp-fec;disable-fec
+	// +optional
+	FECMode string `json:"fecMode,omitempty"`
+
+	// LinkDebounce is the link debounce interval in milliseconds.
+	// +optional
+	LinkDebounce string `json:"linkDebounce,omitempty"`
+}
+
+// LinkLevelPolicyStatus defines the observed state of LinkLevelPolicy.
+type LinkLevelPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LinkLevelPolicy is the Schema for the LinkLevelPolicy API.
+type LinkLevelPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LinkLevelPolicySpec   `json:"spec"`
+	Status LinkLevelPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LinkLevelPolicyList contains a list of LinkLevelPolicy objects.
+type LinkLevelPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LinkLevelPolicy `json:"items"`
+}
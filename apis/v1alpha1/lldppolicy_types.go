@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LLDPPolicySpec defines the desired state of LLDPPolicy.
+type LLDPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LLDPPolicyParameters `json:"forProvider"`
+}
+
+// LLDPPolicyParameters are the configurable fields of LLDPPolicy
+// (lldpIfPol). Optional fields that are left empty keep the APIC
+// default.
+type LLDPPolicyParameters struct {
+	// Name of the LLDP policy, the object is created as
+	// uni/infra/lldpIfP-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the LLDP policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// ReceiveState enables or disables receiving LLDP frames.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	ReceiveState string `json:"receiveState,omitempty"`
+
+	// TransmitState enables or disables sending LLDP frames.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	TransmitState string `json:"transmitState,omitempty"`
+}
+
+// LLDPPolicyStatus defines the observed state of LLDPPolicy.
+type LLDPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LLDPPolicy is the Schema for the LLDPPolicy API.
+type LLDPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LLDPPolicySpec   `json:"spec"`
+	Status LLDPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LLDPPolicyList contains a list of LLDPPolicy objects.
+type LLDPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LLDPPolicy `json:"items"`
+}
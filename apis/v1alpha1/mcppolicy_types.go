@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MCPPolicySpec defines the desired state of MCPPolicy.
+type MCPPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MCPPolicyParameters `json:"forProvider"`
+}
+
+// MCPPolicyParameters are the configurable fields of MCPPolicy (mcpIfPol).
+// Optional fields that are left empty keep the APIC default.
+type MCPPolicyParameters struct {
+	// Name of the MCP policy, the object is created as
+	// uni/infra/mcpIfP-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the MCP policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// AdminState enables or disables MCP on the interface.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	AdminState string `json:"adminState,omitempty"`
+}
+
+// MCPPolicyStatus defines the observed state of MCPPolicy.
+type MCPPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MCPPolicy is the Schema for the MCPPolicy API.
+type MCPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPPolicySpec   `json:"spec"`
+	Status MCPPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MCPPolicyList contains a list of MCPPolicy objects.
+type MCPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MCPPolicy `json:"items"`
+}
@@ -0,0 +1,132 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MicroSegEPGSpec defines the desired state of MicroSegEPG.
+type MicroSegEPGSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MicroSegEPGParameters `json:"forProvider"`
+}
+
+// MicroSegEPGParameters are the configurable fields of MicroSegEPG, an
+// attribute based fvAEPg (isAttrBasedEPg=yes).
+type MicroSegEPGParameters struct {
+	// Name of the EPG, the object is created as
+	// uni/tn-<tenant>/ap-<appProfile>/epg-<name>.
+	Name string `json:"name"`
+
+	// Tenant the EPG belongs to.
+	Tenant string `json:"tenant"`
+
+	// AppProfile is the name of the application profile of the EPG.
+	AppProfile string `json:"appProfile"`
+
+	// Desc is the description of the EPG.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Bd is the name of the bridge domain of the EPG (fvRsBd).
+	Bd string `json:"bd"`
+
+	// Criterion selects the endpoints that belong to the EPG (fvCrtrn).
+	Criterion MicroSegCriterion `json:"criterion"`
+
+	// Domains are the domains the EPG is associated with (fvRsDomAtt).
+	// +optional
+	Domains []DomainAssociation `json:"domains,omitempty"`
+
+	// Contracts the EPG provides, consumes or is protected by.
+	// +optional
+	Contracts ContractRelations `json:"contracts,omitempty"`
+}
+
+// MicroSegCriterion is the attribute criterion block of a uSeg EPG.
+// Attributes that are not listed are removed.
+type MicroSegCriterion struct {
+	// Match selects whether any or all attributes have to match.
+	// +kubebuilder:validation:Enum=any;all
+	// +optional
+	Match string `json:"match,omitempty"`
+
+	// Precedence of the criterion when an endpoint matches several uSeg
+	// EPGs. Higher values win.
+	// +optional
+	Precedence string `json:"precedence,omitempty"`
+
+	// VMAttributes match endpoints on VM properties (fvVmAttr).
+	// +optional
+	VMAttributes []MicroSegVMAttribute `json:"vmAttributes,omitempty"`
+
+	// IPAttributes match endpoints on their IP address (fvIpAttr).
+	// +optional
+	IPAttributes []MicroSegIPAttribute `json:"ipAttributes,omitempty"`
+
+	// MACAttributes match endpoints on their MAC address (fvMacAttr).
+	// +optional
+	MACAttributes []MicroSegMACAttribute `json:"macAttributes,omitempty"`
+}
+
+// MicroSegVMAttribute matches endpoints on a VM property.
+type MicroSegVMAttribute struct {
+	// Name of the attribute.
+	Name string `json:"name"`
+
+	// Type is the VM property to match.
+	// +kubebuilder:validation:Enum=vm-name;guest-os;hv;domain;vm;vnic
+	Type string `json:"type"`
+
+	// Operator is used to compare the property with Value.
+	// +kubebuilder:validation:Enum=equals;contains;startsWith;endsWith
+	// +optional
+	Operator string `json:"operator,omitempty"`
+
+	// Value to match.
+	Value string `json:"value"`
+}
+
+// MicroSegIPAttribute matches endpoints on their IP address.
+type MicroSegIPAttribute struct {
+	// Name of the attribute.
+	Name string `json:"name"`
+
+	// IP is the address or subnet to match.
+	IP string `json:"ip"`
+}
+
+// MicroSegMACAttribute matches endpoints on their MAC address.
+type MicroSegMACAttribute struct {
+	// Name of the attribute.
+	Name string `json:"name"`
+
+	// MAC is the address to match.
+	MAC string `json:"mac"`
+}
+
+// MicroSegEPGStatus defines the observed state of MicroSegEPG.
+type MicroSegEPGStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MicroSegEPG is the Schema for the MicroSegEPG API.
+type MicroSegEPG struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MicroSegEPGSpec   `json:"spec"`
+	Status MicroSegEPGStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MicroSegEPGList contains a list of MicroSegEPG objects.
+type MicroSegEPGList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MicroSegEPG `json:"items"`
+}
@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PhysicalDomainSpec defines the desired state of PhysicalDomain.
+type PhysicalDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PhysicalDomainParameters `json:"forProvider"`
+}
+
+// PhysicalDomainParameters are the configurable fields of PhysicalDomain
+// (physDomP).
+type PhysicalDomainParameters struct {
+	// Name of the domain, the object is created as uni/phys-<name>.
+	Name string `json:"name"`
+
+	// VLANPool is the DN of the VLAN pool of the domain (infraRsVlanNs), for
+	// example uni/infra/vlanns-[servers]-static.
+	// +optional
+	VLANPool string `json:"vlanPool,omitempty"`
+}
+
+// PhysicalDomainStatus defines the observed state of PhysicalDomain.
+type PhysicalDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// PhysicalDomain is the Schema for the PhysicalDomain API.
+type PhysicalDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PhysicalDomainSpec   `json:"spec"`
+	Status PhysicalDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PhysicalDomainList contains a list of PhysicalDomain objects.
+type PhysicalDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PhysicalDomain `json:"items"`
+}
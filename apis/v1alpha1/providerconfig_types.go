@@ -30,8 +30,8 @@ type ProviderConfig struct {
     metav1.TypeMeta   `json:",inline"`
     metav1.ObjectMeta `json:"metadata,omitempty"`
 
-    Spec   xpv1.ResourceSpec  `json:"spec,omitempty"`
-    Status xpv1.ResourceStatus `json:"status,omitempty"`
+    Spec   ProviderConfigSpec   `json:"spec,omitempty"`
+    Status ProviderConfigStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
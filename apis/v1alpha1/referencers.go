@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// readyName returns name once the supplied managed resource is ready. Until
+// then it returns an empty value, which fails reference resolution so that
+// the referencing resource waits for the referenced one.
+func readyName(mg resource.Managed, name string) string {
+	if mg.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue {
+		return ""
+	}
+	return name
+}
+
+// TenantName extracts the ACI name of a referenced Tenant.
+func TenantName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Tenant)
+		if !ok {
+			return ""
+		}
+		return readyName(cr, cr.Spec.ForProvider.Name)
+	}
+}
+
+// ApplicationProfileName extracts the ACI name of a referenced
+// ApplicationProfile.
+func ApplicationProfileName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*ApplicationProfile)
+		if !ok {
+			return ""
+		}
+		return readyName(cr, cr.Spec.ForProvider.Name)
+	}
+}
+
+// BridgeDomainName extracts the ACI name of a referenced BridgeDomain.
+func BridgeDomainName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*BridgeDomain)
+		if !ok {
+			return ""
+		}
+		return readyName(cr, cr.Spec.ForProvider.Name)
+	}
+}
+
+// ResolveReferences of this TenantEPG.
+func (mg *TenantEPG) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Tenant,
+		Reference:    mg.Spec.ForProvider.TenantRef,
+		Selector:     mg.Spec.ForProvider.TenantSelector,
+		To:           reference.To{Managed: &Tenant{}, List: &TenantList{}},
+		Extract:      TenantName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.tenant")
+	}
+	mg.Spec.ForProvider.Tenant = rsp.ResolvedValue
+	mg.Spec.ForProvider.TenantRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.AppProfile,
+		Reference:    mg.Spec.ForProvider.AppProfileRef,
+		Selector:     mg.Spec.ForProvider.AppProfileSelector,
+		To:           reference.To{Managed: &ApplicationProfile{}, List: &ApplicationProfileList{}},
+		Extract:      ApplicationProfileName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.appProfile")
+	}
+	mg.Spec.ForProvider.AppProfile = rsp.ResolvedValue
+	mg.Spec.ForProvider.AppProfileRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Bd,
+		Reference:    mg.Spec.ForProvider.BdRef,
+		Selector:     mg.Spec.ForProvider.BdSelector,
+		To:           reference.To{Managed: &BridgeDomain{}, List: &BridgeDomainList{}},
+		Extract:      BridgeDomainName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bd")
+	}
+	mg.Spec.ForProvider.Bd = rsp.ResolvedValue
+	mg.Spec.ForProvider.BdRef = rsp.ResolvedReference
+
+	return nil
+}
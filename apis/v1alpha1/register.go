@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"reflect"
+
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 )
@@ -16,12 +18,253 @@ var (
 	AddToScheme = SchemeBuilder.AddToScheme
 )
 
+// TenantEPG type metadata.
+var (
+	TenantEPGKind             = reflect.TypeOf(TenantEPG{}).Name()
+	TenantEPGGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: TenantEPGKind}.String()
+	TenantEPGGroupVersionKind = GroupVersion.WithKind(TenantEPGKind)
+)
+
+// Tenant type metadata.
+var (
+	TenantKind             = reflect.TypeOf(Tenant{}).Name()
+	TenantGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: TenantKind}.String()
+	TenantGroupVersionKind = GroupVersion.WithKind(TenantKind)
+)
+
+// VRF type metadata.
+var (
+	VRFKind             = reflect.TypeOf(VRF{}).Name()
+	VRFGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: VRFKind}.String()
+	VRFGroupVersionKind = GroupVersion.WithKind(VRFKind)
+)
+
+// BridgeDomain type metadata.
+var (
+	BridgeDomainKind             = reflect.TypeOf(BridgeDomain{}).Name()
+	BridgeDomainGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: BridgeDomainKind}.String()
+	BridgeDomainGroupVersionKind = GroupVersion.WithKind(BridgeDomainKind)
+)
+
+// BridgeDomainSubnet type metadata.
+var (
+	BridgeDomainSubnetKind             = reflect.TypeOf(BridgeDomainSubnet{}).Name()
+	BridgeDomainSubnetGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: BridgeDomainSubnetKind}.String()
+	BridgeDomainSubnetGroupVersionKind = GroupVersion.WithKind(BridgeDomainSubnetKind)
+)
+
+// ApplicationProfile type metadata.
+var (
+	ApplicationProfileKind             = reflect.TypeOf(ApplicationProfile{}).Name()
+	ApplicationProfileGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: ApplicationProfileKind}.String()
+	ApplicationProfileGroupVersionKind = GroupVersion.WithKind(ApplicationProfileKind)
+)
+
+// Contract type metadata.
+var (
+	ContractKind             = reflect.TypeOf(Contract{}).Name()
+	ContractGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: ContractKind}.String()
+	ContractGroupVersionKind = GroupVersion.WithKind(ContractKind)
+)
+
+// Filter type metadata.
+var (
+	FilterKind             = reflect.TypeOf(Filter{}).Name()
+	FilterGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: FilterKind}.String()
+	FilterGroupVersionKind = GroupVersion.WithKind(FilterKind)
+)
+
+// FilterEntry type metadata.
+var (
+	FilterEntryKind             = reflect.TypeOf(FilterEntry{}).Name()
+	FilterEntryGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: FilterEntryKind}.String()
+	FilterEntryGroupVersionKind = GroupVersion.WithKind(FilterEntryKind)
+)
+
+// L3Out type metadata.
+var (
+	L3OutKind             = reflect.TypeOf(L3Out{}).Name()
+	L3OutGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: L3OutKind}.String()
+	L3OutGroupVersionKind = GroupVersion.WithKind(L3OutKind)
+)
+
+// ExternalEPG type metadata.
+var (
+	ExternalEPGKind             = reflect.TypeOf(ExternalEPG{}).Name()
+	ExternalEPGGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: ExternalEPGKind}.String()
+	ExternalEPGGroupVersionKind = GroupVersion.WithKind(ExternalEPGKind)
+)
+
+// EndpointSecurityGroup type metadata.
+var (
+	EndpointSecurityGroupKind             = reflect.TypeOf(EndpointSecurityGroup{}).Name()
+	EndpointSecurityGroupGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: EndpointSecurityGroupKind}.String()
+	EndpointSecurityGroupGroupVersionKind = GroupVersion.WithKind(EndpointSecurityGroupKind)
+)
+
+// MicroSegEPG type metadata.
+var (
+	MicroSegEPGKind             = reflect.TypeOf(MicroSegEPG{}).Name()
+	MicroSegEPGGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: MicroSegEPGKind}.String()
+	MicroSegEPGGroupVersionKind = GroupVersion.WithKind(MicroSegEPGKind)
+)
+
+// VLANPool type metadata.
+var (
+	VLANPoolKind             = reflect.TypeOf(VLANPool{}).Name()
+	VLANPoolGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: VLANPoolKind}.String()
+	VLANPoolGroupVersionKind = GroupVersion.WithKind(VLANPoolKind)
+)
+
+// PhysicalDomain type metadata.
+var (
+	PhysicalDomainKind             = reflect.TypeOf(PhysicalDomain{}).Name()
+	PhysicalDomainGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: PhysicalDomainKind}.String()
+	PhysicalDomainGroupVersionKind = GroupVersion.WithKind(PhysicalDomainKind)
+)
+
+// AttachableEntityProfile type metadata.
+var (
+	AttachableEntityProfileKind             = reflect.TypeOf(AttachableEntityProfile{}).Name()
+	AttachableEntityProfileGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: AttachableEntityProfileKind}.String()
+	AttachableEntityProfileGroupVersionKind = GroupVersion.WithKind(AttachableEntityProfileKind)
+)
+
+// LeafInterfacePolicyGroup type metadata.
+var (
+	LeafInterfacePolicyGroupKind             = reflect.TypeOf(LeafInterfacePolicyGroup{}).Name()
+	LeafInterfacePolicyGroupGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LeafInterfacePolicyGroupKind}.String()
+	LeafInterfacePolicyGroupGroupVersionKind = GroupVersion.WithKind(LeafInterfacePolicyGroupKind)
+)
+
+// LinkLevelPolicy type metadata.
+var (
+	LinkLevelPolicyKind             = reflect.TypeOf(LinkLevelPolicy{}).Name()
+	LinkLevelPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LinkLevelPolicyKind}.String()
+	LinkLevelPolicyGroupVersionKind = GroupVersion.WithKind(LinkLevelPolicyKind)
+)
+
+// CDPPolicy type metadata.
+var (
+	CDPPolicyKind             = reflect.TypeOf(CDPPolicy{}).Name()
+	CDPPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: CDPPolicyKind}.String()
+	CDPPolicyGroupVersionKind = GroupVersion.WithKind(CDPPolicyKind)
+)
+
+// LLDPPolicy type metadata.
+var (
+	LLDPPolicyKind             = reflect.TypeOf(LLDPPolicy{}).Name()
+	LLDPPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LLDPPolicyKind}.String()
+	LLDPPolicyGroupVersionKind = GroupVersion.WithKind(LLDPPolicyKind)
+)
+
+// LACPPolicy type metadata.
+var (
+	LACPPolicyKind             = reflect.TypeOf(LACPPolicy{}).Name()
+	LACPPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LACPPolicyKind}.String()
+	LACPPolicyGroupVersionKind = GroupVersion.WithKind(LACPPolicyKind)
+)
+
+// MCPPolicy type metadata.
+var (
+	MCPPolicyKind             = reflect.TypeOf(MCPPolicy{}).Name()
+	MCPPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: MCPPolicyKind}.String()
+	MCPPolicyGroupVersionKind = GroupVersion.WithKind(MCPPolicyKind)
+)
+
+// StormControlPolicy type metadata.
+var (
+	StormControlPolicyKind             = reflect.TypeOf(StormControlPolicy{}).Name()
+	StormControlPolicyGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: StormControlPolicyKind}.String()
+	StormControlPolicyGroupVersionKind = GroupVersion.WithKind(StormControlPolicyKind)
+)
+
+// LeafSwitchProfile type metadata.
+var (
+	LeafSwitchProfileKind             = reflect.TypeOf(LeafSwitchProfile{}).Name()
+	LeafSwitchProfileGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LeafSwitchProfileKind}.String()
+	LeafSwitchProfileGroupVersionKind = GroupVersion.WithKind(LeafSwitchProfileKind)
+)
+
+// LeafInterfaceProfile type metadata.
+var (
+	LeafInterfaceProfileKind             = reflect.TypeOf(LeafInterfaceProfile{}).Name()
+	LeafInterfaceProfileGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: LeafInterfaceProfileKind}.String()
+	LeafInterfaceProfileGroupVersionKind = GroupVersion.WithKind(LeafInterfaceProfileKind)
+)
+
+// VPCProtectionGroup type metadata.
+var (
+	VPCProtectionGroupKind             = reflect.TypeOf(VPCProtectionGroup{}).Name()
+	VPCProtectionGroupGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: VPCProtectionGroupKind}.String()
+	VPCProtectionGroupGroupVersionKind = GroupVersion.WithKind(VPCProtectionGroupKind)
+)
+
+// FabricNode type metadata.
+var (
+	FabricNodeKind             = reflect.TypeOf(FabricNode{}).Name()
+	FabricNodeGroupKind        = schema.GroupKind{Group: GroupVersion.Group, Kind: FabricNodeKind}.String()
+	FabricNodeGroupVersionKind = GroupVersion.WithKind(FabricNodeKind)
+)
+
 func init() {
 	SchemeBuilder.Register(
 		&ProviderConfig{},
 		&ProviderConfigList{},
 		&TenantEPG{},
 		&TenantEPGList{},
+		&Tenant{},
+		&TenantList{},
+		&VRF{},
+		&VRFList{},
+		&BridgeDomain{},
+		&BridgeDomainList{},
+		&BridgeDomainSubnet{},
+		&BridgeDomainSubnetList{},
+		&ApplicationProfile{},
+		&ApplicationProfileList{},
+		&Contract{},
+		&ContractList{},
+		&Filter{},
+		&FilterList{},
+		&FilterEntry{},
+		&FilterEntryList{},
+		&L3Out{},
+		&L3OutList{},
+		&ExternalEPG{},
+		&ExternalEPGList{},
+		&EndpointSecurityGroup{},
+		&EndpointSecurityGroupList{},
+		&MicroSegEPG{},
+		&MicroSegEPGList{},
+		&VLANPool{},
+		&VLANPoolList{},
+		&PhysicalDomain{},
+		&PhysicalDomainList{},
+		&AttachableEntityProfile{},
+		&AttachableEntityProfileList{},
+		&LeafInterfacePolicyGroup{},
+		&LeafInterfacePolicyGroupList{},
+		&LinkLevelPolicy{},
+		&LinkLevelPolicyList{},
+		&CDPPolicy{},
+		&CDPPolicyList{},
+		&LLDPPolicy{},
+		&LLDPPolicyList{},
+		&LACPPolicy{},
+		&LACPPolicyList{},
+		&MCPPolicy{},
+		&MCPPolicyList{},
+		&StormControlPolicy{},
+		&StormControlPolicyList{},
+		&LeafSwitchProfile{},
+		&LeafSwitchProfileList{},
+		&LeafInterfaceProfile{},
+		&LeafInterfaceProfileList{},
+		&VPCProtectionGroup{},
+		&VPCProtectionGroupList{},
+		&FabricNode{},
+		&FabricNodeList{},
 		// add additional types here as example: Tenant_BD, Tenant_BDList, etc.
 	)
 }
@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StormControlPolicySpec defines the desired state of StormControlPolicy.
+type StormControlPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StormControlPolicyParameters `json:"forProvider"`
+}
+
+// StormControlPolicyParameters are the configurable fields of StormControlPolicy
+// (stormctrlIfPol). Optional fields that are left empty keep the APIC
+// default.
+type StormControlPolicyParameters struct {
+	// Name of the storm control policy, the object is created as
+	// uni/infra/stormctrlifp-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the storm control policy.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// Rate is the allowed traffic in percent of the link bandwidth.
+	// +optional
+	Rate string `json:"rate,omitempty"`
+
+	// BurstRate is the allowed burst in percent of the link bandwidth.
+	// +optional
+	BurstRate string `json:"burstRate,omitempty"`
+
+	// Action is taken when the rate is exceeded.
+	// +kubebuilder:validation:Enum=drop;shutdown
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// StormControlPolicyStatus defines the observed state of StormControlPolicy.
+type StormControlPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// StormControlPolicy is the Schema for the StormControlPolicy API.
+type StormControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StormControlPolicySpec   `json:"spec"`
+	Status StormControlPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StormControlPolicyList contains a list of StormControlPolicy objects.
+type StormControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StormControlPolicy `json:"items"`
+}
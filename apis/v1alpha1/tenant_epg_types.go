@@ -13,28 +13,239 @@ type TenantEPGSpec struct {
 
 // TenantEPGParameters are the configurable fields of TenantEPG.
 type TenantEPGParameters struct {
-    Name       string `json:"name"`
-    Tenant     string `json:"tenant"`
-    AppProfile string `json:"appProfile"`
-    Desc       string `json:"desc"`
-    Bd         string `json:"bd"`
+    Name string `json:"name"`
+    Desc string `json:"desc"`
+
+    // Tenant the EPG belongs to. Either Tenant, TenantRef or TenantSelector
+    // must be set.
+    // +optional
+    Tenant string `json:"tenant,omitempty"`
+
+    // TenantRef references a Tenant managed resource to set Tenant.
+    // +optional
+    TenantRef *xpv1.Reference `json:"tenantRef,omitempty"`
+
+    // TenantSelector selects a Tenant managed resource to set Tenant.
+    // +optional
+    TenantSelector *xpv1.Selector `json:"tenantSelector,omitempty"`
+
+    // AppProfile is the application profile of the EPG. Either AppProfile,
+    // AppProfileRef or AppProfileSelector must be set.
+    // +optional
+    AppProfile string `json:"appProfile,omitempty"`
+
+    // AppProfileRef references an ApplicationProfile managed resource to
+    // set AppProfile.
+    // +optional
+    AppProfileRef *xpv1.Reference `json:"appProfileRef,omitempty"`
+
+    // AppProfileSelector selects an ApplicationProfile managed resource to
+    // set AppProfile.
+    // +optional
+    AppProfileSelector *xpv1.Selector `json:"appProfileSelector,omitempty"`
+
+    // Bd is the bridge domain of the EPG (fvRsBd). Either Bd, BdRef or
+    // BdSelector must be set.
+    // +optional
+    Bd string `json:"bd,omitempty"`
+
+    // BdRef references a BridgeDomain managed resource to set Bd.
+    // +optional
+    BdRef *xpv1.Reference `json:"bdRef,omitempty"`
+
+    // BdSelector selects a BridgeDomain managed resource to set Bd.
+    // +optional
+    BdSelector *xpv1.Selector `json:"bdSelector,omitempty"`
+
+    // Prio is the QoS class of the EPG. Late-initialized from the APIC when
+    // not set.
+    // +kubebuilder:validation:Enum=unspecified;level1;level2;level3;level4;level5;level6
+    // +optional
+    Prio *string `json:"prio,omitempty"`
+
+    // PrefGrMemb controls membership in the preferred group of the VRF.
+    // Late-initialized from the APIC when not set.
+    // +kubebuilder:validation:Enum=include;exclude
+    // +optional
+    PrefGrMemb *string `json:"prefGrMemb,omitempty"`
+
+    // FloodOnEncap limits flooding to the encapsulation of the EPG.
+    // Late-initialized from the APIC when not set.
+    // +kubebuilder:validation:Enum=enabled;disabled
+    // +optional
+    FloodOnEncap *string `json:"floodOnEncap,omitempty"`
+
+    // PcEnfPref enables intra EPG isolation. Late-initialized from the APIC
+    // when not set.
+    // +kubebuilder:validation:Enum=enforced;unenforced
+    // +optional
+    PcEnfPref *string `json:"pcEnfPref,omitempty"`
+
+    // MatchT is the label match criterion for provided contracts.
+    // Late-initialized from the APIC when not set.
+    // +kubebuilder:validation:Enum=All;AtleastOne;AtmostOne;None
+    // +optional
+    MatchT *string `json:"matchT,omitempty"`
+
+    // Contracts the EPG provides, consumes or is protected by.
+    // +optional
+    Contracts ContractRelations `json:"contracts,omitempty"`
+
+    // StaticPaths are the static port, port-channel and vPC bindings of the
+    // EPG (fvRsPathAtt). Bindings that are not listed are removed.
+    // +optional
+    StaticPaths []StaticPath `json:"staticPaths,omitempty"`
+
+    // Domains are the physical and VMM domains the EPG is associated with
+    // (fvRsDomAtt). Associations that are not listed are removed.
+    // +optional
+    Domains []DomainAssociation `json:"domains,omitempty"`
+}
+
+// DomainAssociation associates the EPG with a physical or VMM domain.
+type DomainAssociation struct {
+    // Domain is the DN of the domain, for example uni/phys-servers or
+    // uni/vmmp-VMware/dom-vcenter.
+    Domain string `json:"domain"`
+
+    // ResolutionImmediacy controls when policies are resolved on the leaf.
+    // +kubebuilder:validation:Enum=immediate;lazy;pre-provision
+    // +optional
+    ResolutionImmediacy string `json:"resolutionImmediacy,omitempty"`
+
+    // DeploymentImmediacy controls when policies are programmed in hardware.
+    // +kubebuilder:validation:Enum=immediate;lazy
+    // +optional
+    DeploymentImmediacy string `json:"deploymentImmediacy,omitempty"`
+
+    // PrimaryEncap is the primary VLAN used for micro-segmentation, for
+    // example vlan-100.
+    // +optional
+    PrimaryEncap string `json:"primaryEncap,omitempty"`
+
+    // MicroSegEncap is the secondary (port) encapsulation used for
+    // micro-segmentation.
+    // +optional
+    MicroSegEncap string `json:"microSegEncap,omitempty"`
+
+    // VMM holds the options that only apply to VMware VMM domains.
+    // +optional
+    VMM *VMMDomainOptions `json:"vmm,omitempty"`
+}
+
+// VMMDomainOptions are the VMware specific settings of a domain association.
+type VMMDomainOptions struct {
+    // AllowPromiscuous sets the promiscuous mode of the port group.
+    // +kubebuilder:validation:Enum=accept;reject
+    // +optional
+    AllowPromiscuous string `json:"allowPromiscuous,omitempty"`
+
+    // ForgedTransmits sets the forged transmits policy of the port group.
+    // +kubebuilder:validation:Enum=accept;reject
+    // +optional
+    ForgedTransmits string `json:"forgedTransmits,omitempty"`
+
+    // MacChanges sets the MAC address changes policy of the port group.
+    // +kubebuilder:validation:Enum=accept;reject
+    // +optional
+    MacChanges string `json:"macChanges,omitempty"`
+
+    // CustomEPGName overrides the name of the port group in vCenter.
+    // +optional
+    CustomEPGName string `json:"customEpgName,omitempty"`
+}
+
+// StaticPath is a static binding of the EPG to a fabric path.
+type StaticPath struct {
+    // Path is the DN of the fabric path, for example
+    // topology/pod-1/paths-101/pathep-[eth1/1] for a port,
+    // topology/pod-1/paths-101/pathep-[pc_pg] for a direct port-channel or
+    // topology/pod-1/protpaths-101-102/pathep-[vpc_pg] for a vPC.
+    Path string `json:"path"`
+
+    // Encap is the encapsulation of the binding, for example vlan-100.
+    Encap string `json:"encap"`
+
+    // Mode is the tagging mode: regular (trunk), native (802.1p) or
+    // untagged (access).
+    // +kubebuilder:validation:Enum=regular;native;untagged
+    // +optional
+    Mode string `json:"mode,omitempty"`
+
+    // Immediacy is the deployment immediacy of the binding.
+    // +kubebuilder:validation:Enum=immediate;lazy
+    // +optional
+    Immediacy string `json:"immediacy,omitempty"`
+}
+
+// TenantEPGObservation are the observable fields of TenantEPG.
+type TenantEPGObservation struct {
+    // DN is the distinguished name of the EPG on the APIC.
+    DN string `json:"dn,omitempty"`
+
+    // PcTag is the class ID the fabric uses to enforce policy for the EPG.
+    PcTag string `json:"pcTag,omitempty"`
+
+    // Scope is the segment ID (VNID) of the VRF the EPG belongs to.
+    Scope string `json:"scope,omitempty"`
+
+    // ConfigIssues lists configuration problems reported by the APIC, ok
+    // if there are none.
+    ConfigIssues string `json:"configIssues,omitempty"`
+
+    // DeploymentStatus is the configuration state of the EPG (configSt),
+    // e.g. applied.
+    DeploymentStatus string `json:"deploymentStatus,omitempty"`
+
+    // BdState is the resolution state of the bridge domain relation
+    // (fvRsBd), e.g. formed or missing-target.
+    BdState string `json:"bdState,omitempty"`
+
+    // BdTargetClass is the class of the object the bridge domain relation
+    // resolved to (fvRsBd tCl).
+    BdTargetClass string `json:"bdTargetClass,omitempty"`
+
+    // HealthScore is the current health score of the EPG.
+    HealthScore string `json:"healthScore,omitempty"`
+
+    // StaticPaths are the static path bindings currently configured on the
+    // APIC.
+    StaticPaths []StaticPathObservation `json:"staticPaths,omitempty"`
+}
+
+// StaticPathObservation is a static path binding as reported by the APIC.
+type StaticPathObservation struct {
+    Path      string `json:"path"`
+    Encap     string `json:"encap,omitempty"`
+    Mode      string `json:"mode,omitempty"`
+    Immediacy string `json:"immediacy,omitempty"`
+
+    // State is the resolution state of the relation, e.g. formed or
+    // missing-target.
+    State string `json:"state,omitempty"`
 }
 
 // TenantEPGStatus defines the observed state of TenantEPG.
 type TenantEPGStatus struct {
     xpv1.ResourceStatus `json:",inline"` // Korrekte Verwendung der Ressourcenstatus
+    AtProvider          TenantEPGObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PCTAG",type="string",JSONPath=".status.atProvider.pcTag"
+// +kubebuilder:printcolumn:name="HEALTH",type="string",JSONPath=".status.atProvider.healthScore"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 
 // TenantEPG is the Schema for the TenantEPG API.
 type TenantEPG struct {
     metav1.TypeMeta   `json:",inline"`
     metav1.ObjectMeta `json:"metadata,omitempty"`
 
-    Spec              xpv1.ResourceSpec   `json:"spec"`
-    Status            xpv1.ResourceStatus `json:"status,omitempty"`
+    Spec              TenantEPGSpec   `json:"spec"`
+    Status            TenantEPGStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TenantSpec defines the desired state of Tenant.
+type TenantSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TenantParameters `json:"forProvider"`
+}
+
+// TenantParameters are the configurable fields of Tenant (fvTenant).
+type TenantParameters struct {
+	// Name of the tenant, the object is created as uni/tn-<name>.
+	Name string `json:"name"`
+
+	// Desc is the description of the tenant.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// NameAlias is the display name of the tenant in the APIC GUI.
+	// +optional
+	NameAlias string `json:"nameAlias,omitempty"`
+
+	// Annotation is a free-form tag stored on the fvTenant object.
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// TenantStatus defines the observed state of Tenant.
+type TenantStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Tenant is the Schema for the Tenant API.
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSpec   `json:"spec"`
+	Status TenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantList contains a list of Tenant objects.
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VLANPoolSpec defines the desired state of VLANPool.
+type VLANPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VLANPoolParameters `json:"forProvider"`
+}
+
+// VLANPoolParameters are the configurable fields of VLANPool (fvnsVlanInstP).
+type VLANPoolParameters struct {
+	// Name of the VLAN pool, the object is created as
+	// uni/infra/vlanns-[<name>]-<allocMode>.
+	Name string `json:"name"`
+
+	// AllocMode is the allocation mode of the pool.
+	// +kubebuilder:validation:Enum=static;dynamic
+	AllocMode string `json:"allocMode"`
+
+	// Desc is the description of the VLAN pool.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// EncapBlocks are the VLAN ranges of the pool (fvnsEncapBlk). Ranges
+	// that are not listed are removed.
+	// +optional
+	EncapBlocks []VLANEncapBlock `json:"encapBlocks,omitempty"`
+}
+
+// VLANEncapBlock is a range of VLAN IDs in a VLAN pool.
+type VLANEncapBlock struct {
+	// From is the first VLAN ID of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	From int `json:"from"`
+
+	// To is the last VLAN ID of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	To int `json:"to"`
+
+	// AllocMode of the range, inherit takes the mode of the pool.
+	// +kubebuilder:validation:Enum=static;dynamic;inherit
+	// +optional
+	AllocMode string `json:"allocMode,omitempty"`
+
+	// Role of the range.
+	// +kubebuilder:validation:Enum=external;internal
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// VLANPoolStatus defines the observed state of VLANPool.
+type VLANPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VLANPool is the Schema for the VLANPool API.
+type VLANPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VLANPoolSpec   `json:"spec"`
+	Status VLANPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VLANPoolList contains a list of VLANPool objects.
+type VLANPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VLANPool `json:"items"`
+}
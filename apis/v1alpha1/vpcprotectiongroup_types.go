@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCProtectionGroupSpec defines the desired state of VPCProtectionGroup.
+type VPCProtectionGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCProtectionGroupParameters `json:"forProvider"`
+}
+
+// VPCProtectionGroupParameters are the configurable fields of
+// VPCProtectionGroup, an explicit vPC protection group (fabricExplicitGEp)
+// under uni/fabric/protpol.
+type VPCProtectionGroupParameters struct {
+	// Name of the protection group, the object is created as
+	// uni/fabric/protpol/expgep-<name>.
+	Name string `json:"name"`
+
+	// GroupID is the logical pair ID of the vPC domain.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	GroupID int `json:"groupId"`
+
+	// NodeIDs are the IDs of the two leaf switches of the vPC pair
+	// (fabricNodePEp).
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	NodeIDs []int `json:"nodeIds"`
+
+	// PodID is the pod of the leaf switches.
+	// +optional
+	PodID string `json:"podId,omitempty"`
+
+	// VPCDomainPolicy is the name of the vPC domain policy
+	// (fabricRsVpcInstPol).
+	// +optional
+	VPCDomainPolicy string `json:"vpcDomainPolicy,omitempty"`
+}
+
+// VPCProtectionGroupStatus defines the observed state of VPCProtectionGroup.
+type VPCProtectionGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VPCProtectionGroup is the Schema for the VPCProtectionGroup API.
+type VPCProtectionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCProtectionGroupSpec   `json:"spec"`
+	Status VPCProtectionGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCProtectionGroupList contains a list of VPCProtectionGroup objects.
+type VPCProtectionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCProtectionGroup `json:"items"`
+}
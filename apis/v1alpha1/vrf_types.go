@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VRFSpec defines the desired state of VRF.
+type VRFSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VRFParameters `json:"forProvider"`
+}
+
+// VRFParameters are the configurable fields of VRF (fvCtx). Optional fields
+// that are left empty keep the APIC default.
+type VRFParameters struct {
+	// Name of the VRF, the object is created as uni/tn-<tenant>/ctx-<name>.
+	Name string `json:"name"`
+
+	// Tenant the VRF belongs to.
+	Tenant string `json:"tenant"`
+
+	// Desc is the description of the VRF.
+	// +optional
+	Desc string `json:"desc,omitempty"`
+
+	// PcEnfPref is the policy control enforcement preference.
+	// +kubebuilder:validation:Enum=enforced;unenforced
+	// +optional
+	PcEnfPref string `json:"pcEnfPref,omitempty"`
+
+	// PcEnfDir is the policy control enforcement direction.
+	// +kubebuilder:validation:Enum=ingress;egress
+	// +optional
+	PcEnfDir string `json:"pcEnfDir,omitempty"`
+
+	// IPDataPlaneLearning enables or disables learning of endpoint IPs from
+	// the data plane.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	IPDataPlaneLearning string `json:"ipDataPlaneLearning,omitempty"`
+
+	// BdEnforcedEnable restricts endpoints to ping only the gateways of
+	// their own bridge domain.
+	// +kubebuilder:validation:Enum=yes;no
+	// +optional
+	BdEnforcedEnable string `json:"bdEnforcedEnable,omitempty"`
+
+	// BgpTimersPolicy is the name of the BGP timers policy (fvRsBgpCtxPol).
+	// +optional
+	BgpTimersPolicy string `json:"bgpTimersPolicy,omitempty"`
+
+	// OspfTimersPolicy is the name of the OSPF timers policy (fvRsOspfCtxPol).
+	// +optional
+	OspfTimersPolicy string `json:"ospfTimersPolicy,omitempty"`
+}
+
+// VRFStatus defines the observed state of VRF.
+type VRFStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VRF is the Schema for the VRF API.
+type VRF struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VRFSpec   `json:"spec"`
+	Status VRFStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VRFList contains a list of VRF objects.
+type VRFList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VRF `json:"items"`
+}
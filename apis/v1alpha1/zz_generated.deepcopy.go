@@ -22,11 +22,3249 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationProfile) DeepCopyInto(out *ApplicationProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfile.
+func (in *ApplicationProfile) DeepCopy() *ApplicationProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationProfileList) DeepCopyInto(out *ApplicationProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileList.
+func (in *ApplicationProfileList) DeepCopy() *ApplicationProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationProfileParameters) DeepCopyInto(out *ApplicationProfileParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileParameters.
+func (in *ApplicationProfileParameters) DeepCopy() *ApplicationProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationProfileSpec) DeepCopyInto(out *ApplicationProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileSpec.
+func (in *ApplicationProfileSpec) DeepCopy() *ApplicationProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationProfileStatus) DeepCopyInto(out *ApplicationProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationProfileStatus.
+func (in *ApplicationProfileStatus) DeepCopy() *ApplicationProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachableEntityProfile) DeepCopyInto(out *AttachableEntityProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachableEntityProfile.
+func (in *AttachableEntityProfile) DeepCopy() *AttachableEntityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachableEntityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AttachableEntityProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachableEntityProfileList) DeepCopyInto(out *AttachableEntityProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AttachableEntityProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachableEntityProfileList.
+func (in *AttachableEntityProfileList) DeepCopy() *AttachableEntityProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachableEntityProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AttachableEntityProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachableEntityProfileParameters) DeepCopyInto(out *AttachableEntityProfileParameters) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachableEntityProfileParameters.
+func (in *AttachableEntityProfileParameters) DeepCopy() *AttachableEntityProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachableEntityProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachableEntityProfileSpec) DeepCopyInto(out *AttachableEntityProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachableEntityProfileSpec.
+func (in *AttachableEntityProfileSpec) DeepCopy() *AttachableEntityProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachableEntityProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttachableEntityProfileStatus) DeepCopyInto(out *AttachableEntityProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttachableEntityProfileStatus.
+func (in *AttachableEntityProfileStatus) DeepCopy() *AttachableEntityProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AttachableEntityProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomain) DeepCopyInto(out *BridgeDomain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomain.
+func (in *BridgeDomain) DeepCopy() *BridgeDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainList) DeepCopyInto(out *BridgeDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BridgeDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainList.
+func (in *BridgeDomainList) DeepCopy() *BridgeDomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainParameters) DeepCopyInto(out *BridgeDomainParameters) {
+	*out = *in
+	if in.L3Outs != nil {
+		in, out := &in.L3Outs, &out.L3Outs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainParameters.
+func (in *BridgeDomainParameters) DeepCopy() *BridgeDomainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSpec) DeepCopyInto(out *BridgeDomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSpec.
+func (in *BridgeDomainSpec) DeepCopy() *BridgeDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainStatus) DeepCopyInto(out *BridgeDomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainStatus.
+func (in *BridgeDomainStatus) DeepCopy() *BridgeDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSubnet) DeepCopyInto(out *BridgeDomainSubnet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSubnet.
+func (in *BridgeDomainSubnet) DeepCopy() *BridgeDomainSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomainSubnet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSubnetList) DeepCopyInto(out *BridgeDomainSubnetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BridgeDomainSubnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSubnetList.
+func (in *BridgeDomainSubnetList) DeepCopy() *BridgeDomainSubnetList {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSubnetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeDomainSubnetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSubnetParameters) DeepCopyInto(out *BridgeDomainSubnetParameters) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSubnetParameters.
+func (in *BridgeDomainSubnetParameters) DeepCopy() *BridgeDomainSubnetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSubnetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSubnetSpec) DeepCopyInto(out *BridgeDomainSubnetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSubnetSpec.
+func (in *BridgeDomainSubnetSpec) DeepCopy() *BridgeDomainSubnetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSubnetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeDomainSubnetStatus) DeepCopyInto(out *BridgeDomainSubnetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeDomainSubnetStatus.
+func (in *BridgeDomainSubnetStatus) DeepCopy() *BridgeDomainSubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeDomainSubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDPPolicy) DeepCopyInto(out *CDPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDPPolicy.
+func (in *CDPPolicy) DeepCopy() *CDPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CDPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CDPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDPPolicyList) DeepCopyInto(out *CDPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CDPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDPPolicyList.
+func (in *CDPPolicyList) DeepCopy() *CDPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CDPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CDPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDPPolicyParameters) DeepCopyInto(out *CDPPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDPPolicyParameters.
+func (in *CDPPolicyParameters) DeepCopy() *CDPPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CDPPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDPPolicySpec) DeepCopyInto(out *CDPPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDPPolicySpec.
+func (in *CDPPolicySpec) DeepCopy() *CDPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CDPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDPPolicyStatus) DeepCopyInto(out *CDPPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDPPolicyStatus.
+func (in *CDPPolicyStatus) DeepCopy() *CDPPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CDPPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Contract) DeepCopyInto(out *Contract) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Contract.
+func (in *Contract) DeepCopy() *Contract {
+	if in == nil {
+		return nil
+	}
+	out := new(Contract)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Contract) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractList) DeepCopyInto(out *ContractList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Contract, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractList.
+func (in *ContractList) DeepCopy() *ContractList {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContractList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractParameters) DeepCopyInto(out *ContractParameters) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]ContractSubject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractParameters.
+func (in *ContractParameters) DeepCopy() *ContractParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractRelations) DeepCopyInto(out *ContractRelations) {
+	*out = *in
+	if in.Provided != nil {
+		in, out := &in.Provided, &out.Provided
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Consumed != nil {
+		in, out := &in.Consumed, &out.Consumed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConsumedInterfaces != nil {
+		in, out := &in.ConsumedInterfaces, &out.ConsumedInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Taboo != nil {
+		in, out := &in.Taboo, &out.Taboo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractRelations.
+func (in *ContractRelations) DeepCopy() *ContractRelations {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractRelations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractSpec) DeepCopyInto(out *ContractSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractSpec.
+func (in *ContractSpec) DeepCopy() *ContractSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractStatus) DeepCopyInto(out *ContractStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractStatus.
+func (in *ContractStatus) DeepCopy() *ContractStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContractSubject) DeepCopyInto(out *ContractSubject) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContractSubject.
+func (in *ContractSubject) DeepCopy() *ContractSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(ContractSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainAssociation) DeepCopyInto(out *DomainAssociation) {
+	*out = *in
+	if in.VMM != nil {
+		in, out := &in.VMM, &out.VMM
+		*out = new(VMMDomainOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAssociation.
+func (in *DomainAssociation) DeepCopy() *DomainAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecurityGroup) DeepCopyInto(out *EndpointSecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecurityGroup.
+func (in *EndpointSecurityGroup) DeepCopy() *EndpointSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EndpointSecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecurityGroupList) DeepCopyInto(out *EndpointSecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EndpointSecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecurityGroupList.
+func (in *EndpointSecurityGroupList) DeepCopy() *EndpointSecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EndpointSecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecurityGroupParameters) DeepCopyInto(out *EndpointSecurityGroupParameters) {
+	*out = *in
+	if in.EPGSelectors != nil {
+		in, out := &in.EPGSelectors, &out.EPGSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPSubnetSelectors != nil {
+		in, out := &in.IPSubnetSelectors, &out.IPSubnetSelectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagSelectors != nil {
+		in, out := &in.TagSelectors, &out.TagSelectors
+		*out = make([]TagSelector, len(*in))
+		copy(*out, *in)
+	}
+	in.Contracts.DeepCopyInto(&out.Contracts)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecurityGroupParameters.
+func (in *EndpointSecurityGroupParameters) DeepCopy() *EndpointSecurityGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecurityGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecurityGroupSpec) DeepCopyInto(out *EndpointSecurityGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecurityGroupSpec.
+func (in *EndpointSecurityGroupSpec) DeepCopy() *EndpointSecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSecurityGroupStatus) DeepCopyInto(out *EndpointSecurityGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSecurityGroupStatus.
+func (in *EndpointSecurityGroupStatus) DeepCopy() *EndpointSecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPG) DeepCopyInto(out *ExternalEPG) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPG.
+func (in *ExternalEPG) DeepCopy() *ExternalEPG {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalEPG) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPGList) DeepCopyInto(out *ExternalEPGList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalEPG, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPGList.
+func (in *ExternalEPGList) DeepCopy() *ExternalEPGList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPGList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalEPGList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPGParameters) DeepCopyInto(out *ExternalEPGParameters) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]ExternalEPGSubnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Contracts.DeepCopyInto(&out.Contracts)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPGParameters.
+func (in *ExternalEPGParameters) DeepCopy() *ExternalEPGParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPGParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPGSpec) DeepCopyInto(out *ExternalEPGSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPGSpec.
+func (in *ExternalEPGSpec) DeepCopy() *ExternalEPGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPGStatus) DeepCopyInto(out *ExternalEPGStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPGStatus.
+func (in *ExternalEPGStatus) DeepCopy() *ExternalEPGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEPGSubnet) DeepCopyInto(out *ExternalEPGSubnet) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Aggregate != nil {
+		in, out := &in.Aggregate, &out.Aggregate
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEPGSubnet.
+func (in *ExternalEPGSubnet) DeepCopy() *ExternalEPGSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEPGSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNode) DeepCopyInto(out *FabricNode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNode.
+func (in *FabricNode) DeepCopy() *FabricNode {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FabricNode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNodeList) DeepCopyInto(out *FabricNodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FabricNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeList.
+func (in *FabricNodeList) DeepCopy() *FabricNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FabricNodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNodeObservation) DeepCopyInto(out *FabricNodeObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeObservation.
+func (in *FabricNodeObservation) DeepCopy() *FabricNodeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNodeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNodeParameters) DeepCopyInto(out *FabricNodeParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeParameters.
+func (in *FabricNodeParameters) DeepCopy() *FabricNodeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNodeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNodeSpec) DeepCopyInto(out *FabricNodeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeSpec.
+func (in *FabricNodeSpec) DeepCopy() *FabricNodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FabricNodeStatus) DeepCopyInto(out *FabricNodeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FabricNodeStatus.
+func (in *FabricNodeStatus) DeepCopy() *FabricNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FabricNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filter) DeepCopyInto(out *Filter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filter.
+func (in *Filter) DeepCopy() *Filter {
+	if in == nil {
+		return nil
+	}
+	out := new(Filter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Filter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterEntry) DeepCopyInto(out *FilterEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterEntry.
+func (in *FilterEntry) DeepCopy() *FilterEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FilterEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterEntryList) DeepCopyInto(out *FilterEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FilterEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterEntryList.
+func (in *FilterEntryList) DeepCopy() *FilterEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FilterEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterEntryParameters) DeepCopyInto(out *FilterEntryParameters) {
+	*out = *in
+	if in.TCPRules != nil {
+		in, out := &in.TCPRules, &out.TCPRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterEntryParameters.
+func (in *FilterEntryParameters) DeepCopy() *FilterEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterEntrySpec) DeepCopyInto(out *FilterEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterEntrySpec.
+func (in *FilterEntrySpec) DeepCopy() *FilterEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterEntryStatus) DeepCopyInto(out *FilterEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterEntryStatus.
+func (in *FilterEntryStatus) DeepCopy() *FilterEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterList) DeepCopyInto(out *FilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterList.
+func (in *FilterList) DeepCopy() *FilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterParameters) DeepCopyInto(out *FilterParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterParameters.
+func (in *FilterParameters) DeepCopy() *FilterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
+func (in *FilterSpec) DeepCopy() *FilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterStatus) DeepCopyInto(out *FilterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStatus.
+func (in *FilterStatus) DeepCopy() *FilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSelector) DeepCopyInto(out *InterfaceSelector) {
+	*out = *in
+	if in.PortBlocks != nil {
+		in, out := &in.PortBlocks, &out.PortBlocks
+		*out = make([]PortBlock, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSelector.
+func (in *InterfaceSelector) DeepCopy() *InterfaceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3Out) DeepCopyInto(out *L3Out) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3Out.
+func (in *L3Out) DeepCopy() *L3Out {
+	if in == nil {
+		return nil
+	}
+	out := new(L3Out)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *L3Out) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutInterfaceProfile) DeepCopyInto(out *L3OutInterfaceProfile) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]L3OutPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutInterfaceProfile.
+func (in *L3OutInterfaceProfile) DeepCopy() *L3OutInterfaceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutInterfaceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutList) DeepCopyInto(out *L3OutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]L3Out, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutList.
+func (in *L3OutList) DeepCopy() *L3OutList {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *L3OutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutNode) DeepCopyInto(out *L3OutNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutNode.
+func (in *L3OutNode) DeepCopy() *L3OutNode {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutNodeProfile) DeepCopyInto(out *L3OutNodeProfile) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]L3OutNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterfaceProfiles != nil {
+		in, out := &in.InterfaceProfiles, &out.InterfaceProfiles
+		*out = make([]L3OutInterfaceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutNodeProfile.
+func (in *L3OutNodeProfile) DeepCopy() *L3OutNodeProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutNodeProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutParameters) DeepCopyInto(out *L3OutParameters) {
+	*out = *in
+	if in.NodeProfiles != nil {
+		in, out := &in.NodeProfiles, &out.NodeProfiles
+		*out = make([]L3OutNodeProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutParameters.
+func (in *L3OutParameters) DeepCopy() *L3OutParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutPath) DeepCopyInto(out *L3OutPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutPath.
+func (in *L3OutPath) DeepCopy() *L3OutPath {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutSpec) DeepCopyInto(out *L3OutSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutSpec.
+func (in *L3OutSpec) DeepCopy() *L3OutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L3OutStatus) DeepCopyInto(out *L3OutStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L3OutStatus.
+func (in *L3OutStatus) DeepCopy() *L3OutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(L3OutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LACPPolicy) DeepCopyInto(out *LACPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LACPPolicy.
+func (in *LACPPolicy) DeepCopy() *LACPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LACPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LACPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LACPPolicyList) DeepCopyInto(out *LACPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LACPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LACPPolicyList.
+func (in *LACPPolicyList) DeepCopy() *LACPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LACPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LACPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LACPPolicyParameters) DeepCopyInto(out *LACPPolicyParameters) {
+	*out = *in
+	if in.Control != nil {
+		in, out := &in.Control, &out.Control
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LACPPolicyParameters.
+func (in *LACPPolicyParameters) DeepCopy() *LACPPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LACPPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LACPPolicySpec) DeepCopyInto(out *LACPPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LACPPolicySpec.
+func (in *LACPPolicySpec) DeepCopy() *LACPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LACPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LACPPolicyStatus) DeepCopyInto(out *LACPPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LACPPolicyStatus.
+func (in *LACPPolicyStatus) DeepCopy() *LACPPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LACPPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLDPPolicy) DeepCopyInto(out *LLDPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLDPPolicy.
+func (in *LLDPPolicy) DeepCopy() *LLDPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LLDPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LLDPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLDPPolicyList) DeepCopyInto(out *LLDPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LLDPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLDPPolicyList.
+func (in *LLDPPolicyList) DeepCopy() *LLDPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LLDPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LLDPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLDPPolicyParameters) DeepCopyInto(out *LLDPPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLDPPolicyParameters.
+func (in *LLDPPolicyParameters) DeepCopy() *LLDPPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LLDPPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLDPPolicySpec) DeepCopyInto(out *LLDPPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLDPPolicySpec.
+func (in *LLDPPolicySpec) DeepCopy() *LLDPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LLDPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LLDPPolicyStatus) DeepCopyInto(out *LLDPPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLDPPolicyStatus.
+func (in *LLDPPolicyStatus) DeepCopy() *LLDPPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LLDPPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfacePolicyGroup) DeepCopyInto(out *LeafInterfacePolicyGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfacePolicyGroup.
+func (in *LeafInterfacePolicyGroup) DeepCopy() *LeafInterfacePolicyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfacePolicyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafInterfacePolicyGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfacePolicyGroupList) DeepCopyInto(out *LeafInterfacePolicyGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LeafInterfacePolicyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfacePolicyGroupList.
+func (in *LeafInterfacePolicyGroupList) DeepCopy() *LeafInterfacePolicyGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfacePolicyGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafInterfacePolicyGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfacePolicyGroupParameters) DeepCopyInto(out *LeafInterfacePolicyGroupParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfacePolicyGroupParameters.
+func (in *LeafInterfacePolicyGroupParameters) DeepCopy() *LeafInterfacePolicyGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfacePolicyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfacePolicyGroupSpec) DeepCopyInto(out *LeafInterfacePolicyGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfacePolicyGroupSpec.
+func (in *LeafInterfacePolicyGroupSpec) DeepCopy() *LeafInterfacePolicyGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfacePolicyGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfacePolicyGroupStatus) DeepCopyInto(out *LeafInterfacePolicyGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfacePolicyGroupStatus.
+func (in *LeafInterfacePolicyGroupStatus) DeepCopy() *LeafInterfacePolicyGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfacePolicyGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfaceProfile) DeepCopyInto(out *LeafInterfaceProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfaceProfile.
+func (in *LeafInterfaceProfile) DeepCopy() *LeafInterfaceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfaceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafInterfaceProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfaceProfileList) DeepCopyInto(out *LeafInterfaceProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LeafInterfaceProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfaceProfileList.
+func (in *LeafInterfaceProfileList) DeepCopy() *LeafInterfaceProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfaceProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafInterfaceProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfaceProfileParameters) DeepCopyInto(out *LeafInterfaceProfileParameters) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]InterfaceSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfaceProfileParameters.
+func (in *LeafInterfaceProfileParameters) DeepCopy() *LeafInterfaceProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfaceProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfaceProfileSpec) DeepCopyInto(out *LeafInterfaceProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfaceProfileSpec.
+func (in *LeafInterfaceProfileSpec) DeepCopy() *LeafInterfaceProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfaceProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafInterfaceProfileStatus) DeepCopyInto(out *LeafInterfaceProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafInterfaceProfileStatus.
+func (in *LeafInterfaceProfileStatus) DeepCopy() *LeafInterfaceProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafInterfaceProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSelector) DeepCopyInto(out *LeafSelector) {
+	*out = *in
+	if in.NodeBlocks != nil {
+		in, out := &in.NodeBlocks, &out.NodeBlocks
+		*out = make([]NodeBlock, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSelector.
+func (in *LeafSelector) DeepCopy() *LeafSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSwitchProfile) DeepCopyInto(out *LeafSwitchProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSwitchProfile.
+func (in *LeafSwitchProfile) DeepCopy() *LeafSwitchProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSwitchProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafSwitchProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSwitchProfileList) DeepCopyInto(out *LeafSwitchProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LeafSwitchProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSwitchProfileList.
+func (in *LeafSwitchProfileList) DeepCopy() *LeafSwitchProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSwitchProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LeafSwitchProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSwitchProfileParameters) DeepCopyInto(out *LeafSwitchProfileParameters) {
+	*out = *in
+	if in.LeafSelectors != nil {
+		in, out := &in.LeafSelectors, &out.LeafSelectors
+		*out = make([]LeafSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InterfaceProfiles != nil {
+		in, out := &in.InterfaceProfiles, &out.InterfaceProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSwitchProfileParameters.
+func (in *LeafSwitchProfileParameters) DeepCopy() *LeafSwitchProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSwitchProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSwitchProfileSpec) DeepCopyInto(out *LeafSwitchProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSwitchProfileSpec.
+func (in *LeafSwitchProfileSpec) DeepCopy() *LeafSwitchProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSwitchProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeafSwitchProfileStatus) DeepCopyInto(out *LeafSwitchProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeafSwitchProfileStatus.
+func (in *LeafSwitchProfileStatus) DeepCopy() *LeafSwitchProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LeafSwitchProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkLevelPolicy) DeepCopyInto(out *LinkLevelPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkLevelPolicy.
+func (in *LinkLevelPolicy) DeepCopy() *LinkLevelPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkLevelPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinkLevelPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkLevelPolicyList) DeepCopyInto(out *LinkLevelPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LinkLevelPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkLevelPolicyList.
+func (in *LinkLevelPolicyList) DeepCopy() *LinkLevelPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkLevelPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LinkLevelPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkLevelPolicyParameters) DeepCopyInto(out *LinkLevelPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkLevelPolicyParameters.
+func (in *LinkLevelPolicyParameters) DeepCopy() *LinkLevelPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkLevelPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkLevelPolicySpec) DeepCopyInto(out *LinkLevelPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkLevelPolicySpec.
+func (in *LinkLevelPolicySpec) DeepCopy() *LinkLevelPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkLevelPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkLevelPolicyStatus) DeepCopyInto(out *LinkLevelPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkLevelPolicyStatus.
+func (in *LinkLevelPolicyStatus) DeepCopy() *LinkLevelPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkLevelPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicy) DeepCopyInto(out *MCPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicy.
+func (in *MCPPolicy) DeepCopy() *MCPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicyList) DeepCopyInto(out *MCPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicyList.
+func (in *MCPPolicyList) DeepCopy() *MCPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicyParameters) DeepCopyInto(out *MCPPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicyParameters.
+func (in *MCPPolicyParameters) DeepCopy() *MCPPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicySpec) DeepCopyInto(out *MCPPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicySpec.
+func (in *MCPPolicySpec) DeepCopy() *MCPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPolicyStatus) DeepCopyInto(out *MCPPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPolicyStatus.
+func (in *MCPPolicyStatus) DeepCopy() *MCPPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegCriterion) DeepCopyInto(out *MicroSegCriterion) {
+	*out = *in
+	if in.VMAttributes != nil {
+		in, out := &in.VMAttributes, &out.VMAttributes
+		*out = make([]MicroSegVMAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAttributes != nil {
+		in, out := &in.IPAttributes, &out.IPAttributes
+		*out = make([]MicroSegIPAttribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.MACAttributes != nil {
+		in, out := &in.MACAttributes, &out.MACAttributes
+		*out = make([]MicroSegMACAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegCriterion.
+func (in *MicroSegCriterion) DeepCopy() *MicroSegCriterion {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegCriterion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegEPG) DeepCopyInto(out *MicroSegEPG) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegEPG.
+func (in *MicroSegEPG) DeepCopy() *MicroSegEPG {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegEPG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MicroSegEPG) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegEPGList) DeepCopyInto(out *MicroSegEPGList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MicroSegEPG, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegEPGList.
+func (in *MicroSegEPGList) DeepCopy() *MicroSegEPGList {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegEPGList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MicroSegEPGList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegEPGParameters) DeepCopyInto(out *MicroSegEPGParameters) {
+	*out = *in
+	in.Criterion.DeepCopyInto(&out.Criterion)
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]DomainAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Contracts.DeepCopyInto(&out.Contracts)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegEPGParameters.
+func (in *MicroSegEPGParameters) DeepCopy() *MicroSegEPGParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegEPGParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegEPGSpec) DeepCopyInto(out *MicroSegEPGSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegEPGSpec.
+func (in *MicroSegEPGSpec) DeepCopy() *MicroSegEPGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegEPGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegEPGStatus) DeepCopyInto(out *MicroSegEPGStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegEPGStatus.
+func (in *MicroSegEPGStatus) DeepCopy() *MicroSegEPGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegEPGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegIPAttribute) DeepCopyInto(out *MicroSegIPAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegIPAttribute.
+func (in *MicroSegIPAttribute) DeepCopy() *MicroSegIPAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegIPAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegMACAttribute) DeepCopyInto(out *MicroSegMACAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegMACAttribute.
+func (in *MicroSegMACAttribute) DeepCopy() *MicroSegMACAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegMACAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MicroSegVMAttribute) DeepCopyInto(out *MicroSegVMAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MicroSegVMAttribute.
+func (in *MicroSegVMAttribute) DeepCopy() *MicroSegVMAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(MicroSegVMAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeBlock) DeepCopyInto(out *NodeBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeBlock.
+func (in *NodeBlock) DeepCopy() *NodeBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDomain) DeepCopyInto(out *PhysicalDomain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDomain.
+func (in *PhysicalDomain) DeepCopy() *PhysicalDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhysicalDomain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDomainList) DeepCopyInto(out *PhysicalDomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PhysicalDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDomainList.
+func (in *PhysicalDomainList) DeepCopy() *PhysicalDomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhysicalDomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDomainParameters) DeepCopyInto(out *PhysicalDomainParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDomainParameters.
+func (in *PhysicalDomainParameters) DeepCopy() *PhysicalDomainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDomainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDomainSpec) DeepCopyInto(out *PhysicalDomainSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDomainSpec.
+func (in *PhysicalDomainSpec) DeepCopy() *PhysicalDomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalDomainStatus) DeepCopyInto(out *PhysicalDomainStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalDomainStatus.
+func (in *PhysicalDomainStatus) DeepCopy() *PhysicalDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortBlock) DeepCopyInto(out *PortBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortBlock.
+func (in *PortBlock) DeepCopy() *PortBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(PortBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
+func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProviderConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
+func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
+func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
+func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPath) DeepCopyInto(out *StaticPath) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPath.
+func (in *StaticPath) DeepCopy() *StaticPath {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticPathObservation) DeepCopyInto(out *StaticPathObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPathObservation.
+func (in *StaticPathObservation) DeepCopy() *StaticPathObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticPathObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlPolicy) DeepCopyInto(out *StormControlPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlPolicy.
+func (in *StormControlPolicy) DeepCopy() *StormControlPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StormControlPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlPolicyList) DeepCopyInto(out *StormControlPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StormControlPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlPolicyList.
+func (in *StormControlPolicyList) DeepCopy() *StormControlPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StormControlPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlPolicyParameters) DeepCopyInto(out *StormControlPolicyParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlPolicyParameters.
+func (in *StormControlPolicyParameters) DeepCopy() *StormControlPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlPolicySpec) DeepCopyInto(out *StormControlPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlPolicySpec.
+func (in *StormControlPolicySpec) DeepCopy() *StormControlPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControlPolicyStatus) DeepCopyInto(out *StormControlPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControlPolicyStatus.
+func (in *StormControlPolicyStatus) DeepCopy() *StormControlPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControlPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSelector) DeepCopyInto(out *TagSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSelector.
+func (in *TagSelector) DeepCopy() *TagSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tenant) DeepCopyInto(out *Tenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tenant.
+func (in *Tenant) DeepCopy() *Tenant {
+	if in == nil {
+		return nil
+	}
+	out := new(Tenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPG) DeepCopyInto(out *TenantEPG) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPG.
+func (in *TenantEPG) DeepCopy() *TenantEPG {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPG)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantEPG) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPGList) DeepCopyInto(out *TenantEPGList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TenantEPG, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGList.
+func (in *TenantEPGList) DeepCopy() *TenantEPGList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPGList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantEPGList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPGObservation) DeepCopyInto(out *TenantEPGObservation) {
+	*out = *in
+	if in.StaticPaths != nil {
+		in, out := &in.StaticPaths, &out.StaticPaths
+		*out = make([]StaticPathObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGObservation.
+func (in *TenantEPGObservation) DeepCopy() *TenantEPGObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPGObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPGParameters) DeepCopyInto(out *TenantEPGParameters) {
+	*out = *in
+	if in.TenantRef != nil {
+		in, out := &in.TenantRef, &out.TenantRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TenantSelector != nil {
+		in, out := &in.TenantSelector, &out.TenantSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppProfileRef != nil {
+		in, out := &in.AppProfileRef, &out.AppProfileRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppProfileSelector != nil {
+		in, out := &in.AppProfileSelector, &out.AppProfileSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BdRef != nil {
+		in, out := &in.BdRef, &out.BdRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BdSelector != nil {
+		in, out := &in.BdSelector, &out.BdSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prio != nil {
+		in, out := &in.Prio, &out.Prio
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefGrMemb != nil {
+		in, out := &in.PrefGrMemb, &out.PrefGrMemb
+		*out = new(string)
+		**out = **in
+	}
+	if in.FloodOnEncap != nil {
+		in, out := &in.FloodOnEncap, &out.FloodOnEncap
+		*out = new(string)
+		**out = **in
+	}
+	if in.PcEnfPref != nil {
+		in, out := &in.PcEnfPref, &out.PcEnfPref
+		*out = new(string)
+		**out = **in
+	}
+	if in.MatchT != nil {
+		in, out := &in.MatchT, &out.MatchT
+		*out = new(string)
+		**out = **in
+	}
+	in.Contracts.DeepCopyInto(&out.Contracts)
+	if in.StaticPaths != nil {
+		in, out := &in.StaticPaths, &out.StaticPaths
+		*out = make([]StaticPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]DomainAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGParameters.
+func (in *TenantEPGParameters) DeepCopy() *TenantEPGParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPGParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPGSpec) DeepCopyInto(out *TenantEPGSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGSpec.
+func (in *TenantEPGSpec) DeepCopy() *TenantEPGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantEPGStatus) DeepCopyInto(out *TenantEPGStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGStatus.
+func (in *TenantEPGStatus) DeepCopy() *TenantEPGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantEPGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantList) DeepCopyInto(out *TenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantList.
+func (in *TenantList) DeepCopy() *TenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantParameters) DeepCopyInto(out *TenantParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantParameters.
+func (in *TenantParameters) DeepCopy() *TenantParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantSpec.
+func (in *TenantSpec) DeepCopy() *TenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantStatus) DeepCopyInto(out *TenantStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantStatus.
+func (in *TenantStatus) DeepCopy() *TenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANEncapBlock) DeepCopyInto(out *VLANEncapBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANEncapBlock.
+func (in *VLANEncapBlock) DeepCopy() *VLANEncapBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANEncapBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANPool) DeepCopyInto(out *VLANPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -34,18 +3272,18 @@ func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfig.
-func (in *ProviderConfig) DeepCopy() *ProviderConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANPool.
+func (in *VLANPool) DeepCopy() *VLANPool {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfig)
+	out := new(VLANPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfig) DeepCopyObject() runtime.Object {
+func (in *VLANPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +3291,31 @@ func (in *ProviderConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderConfigList) DeepCopyInto(out *ProviderConfigList) {
+func (in *VLANPoolList) DeepCopyInto(out *VLANPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ProviderConfig, len(*in))
+		*out = make([]VLANPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigList.
-func (in *ProviderConfigList) DeepCopy() *ProviderConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANPoolList.
+func (in *VLANPoolList) DeepCopy() *VLANPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderConfigList)
+	out := new(VLANPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
+func (in *VLANPoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,7 +3323,75 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantEPG) DeepCopyInto(out *TenantEPG) {
+func (in *VLANPoolParameters) DeepCopyInto(out *VLANPoolParameters) {
+	*out = *in
+	if in.EncapBlocks != nil {
+		in, out := &in.EncapBlocks, &out.EncapBlocks
+		*out = make([]VLANEncapBlock, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANPoolParameters.
+func (in *VLANPoolParameters) DeepCopy() *VLANPoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANPoolSpec) DeepCopyInto(out *VLANPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANPoolSpec.
+func (in *VLANPoolSpec) DeepCopy() *VLANPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANPoolStatus) DeepCopyInto(out *VLANPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANPoolStatus.
+func (in *VLANPoolStatus) DeepCopy() *VLANPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMMDomainOptions) DeepCopyInto(out *VMMDomainOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMMDomainOptions.
+func (in *VMMDomainOptions) DeepCopy() *VMMDomainOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VMMDomainOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCProtectionGroup) DeepCopyInto(out *VPCProtectionGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -93,18 +3399,18 @@ func (in *TenantEPG) DeepCopyInto(out *TenantEPG) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPG.
-func (in *TenantEPG) DeepCopy() *TenantEPG {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProtectionGroup.
+func (in *VPCProtectionGroup) DeepCopy() *VPCProtectionGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantEPG)
+	out := new(VPCProtectionGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantEPG) DeepCopyObject() runtime.Object {
+func (in *VPCProtectionGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -112,33 +3418,193 @@ func (in *TenantEPG) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TenantEPGList) DeepCopyInto(out *TenantEPGList) {
+func (in *VPCProtectionGroupList) DeepCopyInto(out *VPCProtectionGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]TenantEPG, len(*in))
+		*out = make([]VPCProtectionGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantEPGList.
-func (in *TenantEPGList) DeepCopy() *TenantEPGList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProtectionGroupList.
+func (in *VPCProtectionGroupList) DeepCopy() *VPCProtectionGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(TenantEPGList)
+	out := new(VPCProtectionGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TenantEPGList) DeepCopyObject() runtime.Object {
+func (in *VPCProtectionGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCProtectionGroupParameters) DeepCopyInto(out *VPCProtectionGroupParameters) {
+	*out = *in
+	if in.NodeIDs != nil {
+		in, out := &in.NodeIDs, &out.NodeIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProtectionGroupParameters.
+func (in *VPCProtectionGroupParameters) DeepCopy() *VPCProtectionGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCProtectionGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCProtectionGroupSpec) DeepCopyInto(out *VPCProtectionGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProtectionGroupSpec.
+func (in *VPCProtectionGroupSpec) DeepCopy() *VPCProtectionGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCProtectionGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCProtectionGroupStatus) DeepCopyInto(out *VPCProtectionGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProtectionGroupStatus.
+func (in *VPCProtectionGroupStatus) DeepCopy() *VPCProtectionGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCProtectionGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRF) DeepCopyInto(out *VRF) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRF.
+func (in *VRF) DeepCopy() *VRF {
+	if in == nil {
+		return nil
+	}
+	out := new(VRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VRF) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRFList) DeepCopyInto(out *VRFList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VRF, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRFList.
+func (in *VRFList) DeepCopy() *VRFList {
+	if in == nil {
+		return nil
+	}
+	out := new(VRFList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VRFList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRFParameters) DeepCopyInto(out *VRFParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRFParameters.
+func (in *VRFParameters) DeepCopy() *VRFParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VRFParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRFSpec) DeepCopyInto(out *VRFSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRFSpec.
+func (in *VRFSpec) DeepCopy() *VRFSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VRFSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRFStatus) DeepCopyInto(out *VRFStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRFStatus.
+func (in *VRFStatus) DeepCopy() *VRFStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VRFStatus)
+	in.DeepCopyInto(out)
+	return out
+}
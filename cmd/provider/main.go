@@ -7,6 +7,7 @@ import (
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -14,8 +15,8 @@ import (
 	// Import global API types
 	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
 
-	// Import TenantEPG-Controller
-	epgcontroller "github.com/patrikbolt/crossplane_provider_cisco_aci/internal/controller"
+	// Import controllers
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/controller"
 )
 
 func main() {
@@ -38,35 +39,37 @@ func main() {
 	}
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		SyncPeriod: syncInterval,
+		Cache: cache.Options{
+			SyncPeriod: syncInterval,
+		},
 	})
 	if err != nil {
-		log.Error(err, "Error creating controller manager")
+		zl.Error(err, "Error creating controller manager")
 		os.Exit(1)
 	}
 
 	// Register API schema
 	if err := v1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
-		log.Error(err, "Error adding API schema")
+		zl.Error(err, "Error adding API schema")
 		os.Exit(1)
 	}
 
-	o := epgcontroller.Options{
+	o := controller.Options{
 		Logger:                  log,
 		MaxConcurrentReconciles: *maxReconcile,
 		PollInterval:            *pollInterval,
 	}
 
-	// Setup TenantEPG controller
-	if err := epgcontroller.SetupTenantEPGController(mgr, o); err != nil {
-		log.Error(err, "Error setting up TenantEPG controller")
+	// Setup all managed resource controllers
+	if err := controller.Setup(mgr, o); err != nil {
+		zl.Error(err, "Error setting up controllers")
 		os.Exit(1)
 	}
 
 	// Start the manager
 	log.Info("Starting controller manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		log.Error(err, "Error running manager")
+		zl.Error(err, "Error running manager")
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// ApplicationProfileClient verwaltet Operationen für Application Profiles (fvAp) in Cisco ACI
+type ApplicationProfileClient struct {
+	client *Client
+}
+
+// NewApplicationProfileClient initialisiert einen neuen ApplicationProfile-Client
+func NewApplicationProfileClient(client *Client) *ApplicationProfileClient {
+	return &ApplicationProfileClient{
+		client: client,
+	}
+}
+
+// ApplicationProfileDN liefert den DN eines Application Profiles
+func ApplicationProfileDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/ap-%s", tenant, name)
+}
+
+// applicationProfileAttributes liefert die konfigurierbaren fvAp-Attribute; leere optionale Werte werden weggelassen
+func applicationProfileAttributes(p v1alpha1.ApplicationProfileParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"prio": p.Prio,
+	})
+}
+
+// applicationProfilePayload baut die fvAp-Payload inklusive der Monitoring-Relation auf
+func applicationProfilePayload(p v1alpha1.ApplicationProfileParameters, status string, observed []ManagedObject) map[string]interface{} {
+	attrs := applicationProfileAttributes(p)
+	attrs["dn"] = ApplicationProfileDN(p.Tenant, p.Name)
+	attrs["status"] = status
+
+	children := relationChildren("fvRsApMonPol", "tnMonEPGPolName", optionalList(p.MonitoringPolicy), observed)
+
+	return map[string]interface{}{
+		"fvAp": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateApplicationProfile erstellt ein neues Application Profile in Cisco ACI
+func (c *ApplicationProfileClient) CreateApplicationProfile(p v1alpha1.ApplicationProfileParameters) error {
+	if err := c.client.PostMO(ApplicationProfileDN(p.Tenant, p.Name), applicationProfilePayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des ApplicationProfiles: %v", err)
+	}
+
+	log.Println("ApplicationProfile erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateApplicationProfile aktualisiert ein bestehendes Application Profile in Cisco ACI
+func (c *ApplicationProfileClient) UpdateApplicationProfile(p v1alpha1.ApplicationProfileParameters) error {
+	current, err := c.ObserveApplicationProfile(p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+	var observed []ManagedObject
+	if current != nil {
+		observed = current.Children
+	}
+
+	if err := c.client.PostMO(ApplicationProfileDN(p.Tenant, p.Name), applicationProfilePayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des ApplicationProfiles: %v", err)
+	}
+
+	log.Println("ApplicationProfile erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteApplicationProfile löscht ein bestehendes Application Profile in Cisco ACI
+func (c *ApplicationProfileClient) DeleteApplicationProfile(tenant, name string) error {
+	data := map[string]interface{}{
+		"fvAp": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(ApplicationProfileDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des ApplicationProfiles: %v", err)
+	}
+
+	log.Println("ApplicationProfile erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveApplicationProfile liest ein Application Profile samt Relationen aus Cisco ACI. Gibt nil zurück, wenn es nicht existiert.
+func (c *ApplicationProfileClient) ObserveApplicationProfile(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(ApplicationProfileDN(tenant, name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des ApplicationProfiles: %w", err)
+	}
+	return mo, nil
+}
+
+// ApplicationProfileUpToDate prüft, ob das beobachtete Application Profile der Spezifikation entspricht
+func ApplicationProfileUpToDate(mo *ManagedObject, p v1alpha1.ApplicationProfileParameters) bool {
+	if !attributesMatch(mo.Attributes, applicationProfileAttributes(p)) {
+		return false
+	}
+	return relationsMatch("fvRsApMonPol", "tnMonEPGPolName", optionalList(p.MonitoringPolicy), mo.Children)
+}
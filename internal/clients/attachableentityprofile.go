@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// AttachableEntityProfileClient verwaltet Operationen für AAEPs (infraAttEntityP) in Cisco ACI
+type AttachableEntityProfileClient struct {
+	client *Client
+}
+
+// NewAttachableEntityProfileClient initialisiert einen neuen AttachableEntityProfile-Client
+func NewAttachableEntityProfileClient(client *Client) *AttachableEntityProfileClient {
+	return &AttachableEntityProfileClient{
+		client: client,
+	}
+}
+
+// AttachableEntityProfileDN liefert den DN eines AAEP
+func AttachableEntityProfileDN(name string) string {
+	return fmt.Sprintf("uni/infra/attentp-%s", name)
+}
+
+// attachableEntityProfilePayload baut die infraAttEntityP-Payload inklusive der Domänen-Relationen auf
+func attachableEntityProfilePayload(p v1alpha1.AttachableEntityProfileParameters, status string, observed *ManagedObject) map[string]interface{} {
+	return map[string]interface{}{
+		"infraAttEntityP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     AttachableEntityProfileDN(p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": relationChildren("infraRsDomP", "tDn", p.Domains, observed.ChildrenOf("infraRsDomP")),
+		},
+	}
+}
+
+// CreateAttachableEntityProfile erstellt ein neues AAEP in Cisco ACI
+func (c *AttachableEntityProfileClient) CreateAttachableEntityProfile(p v1alpha1.AttachableEntityProfileParameters) error {
+	if err := c.client.PostMO(AttachableEntityProfileDN(p.Name), attachableEntityProfilePayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des AAEP: %v", err)
+	}
+
+	log.Println("AAEP erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateAttachableEntityProfile aktualisiert ein bestehendes AAEP in Cisco ACI
+func (c *AttachableEntityProfileClient) UpdateAttachableEntityProfile(p v1alpha1.AttachableEntityProfileParameters) error {
+	observed, err := c.ObserveAttachableEntityProfile(p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(AttachableEntityProfileDN(p.Name), attachableEntityProfilePayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des AAEP: %v", err)
+	}
+
+	log.Println("AAEP erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteAttachableEntityProfile löscht ein bestehendes AAEP in Cisco ACI
+func (c *AttachableEntityProfileClient) DeleteAttachableEntityProfile(name string) error {
+	data := map[string]interface{}{
+		"infraAttEntityP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(AttachableEntityProfileDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des AAEP: %v", err)
+	}
+
+	log.Println("AAEP erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveAttachableEntityProfile liest ein AAEP samt Relationen aus Cisco ACI. Gibt nil zurück, wenn es nicht existiert.
+func (c *AttachableEntityProfileClient) ObserveAttachableEntityProfile(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(AttachableEntityProfileDN(name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des AAEP: %w", err)
+	}
+	return mo, nil
+}
+
+// AttachableEntityProfileUpToDate prüft, ob das beobachtete AAEP der Spezifikation entspricht
+func AttachableEntityProfileUpToDate(mo *ManagedObject, p v1alpha1.AttachableEntityProfileParameters) bool {
+	return mo.Attributes["descr"] == p.Desc && relationsMatch("infraRsDomP", "tDn", p.Domains, mo.Children)
+}
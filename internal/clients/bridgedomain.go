@@ -0,0 +1,134 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// BridgeDomainClient verwaltet Operationen für Bridge Domains (fvBD) in Cisco ACI
+type BridgeDomainClient struct {
+	client *Client
+}
+
+// NewBridgeDomainClient initialisiert einen neuen BridgeDomain-Client
+func NewBridgeDomainClient(client *Client) *BridgeDomainClient {
+	return &BridgeDomainClient{
+		client: client,
+	}
+}
+
+// BridgeDomainDN liefert den DN einer Bridge Domain
+func BridgeDomainDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/BD-%s", tenant, name)
+}
+
+// bridgeDomainAttributes liefert die konfigurierbaren fvBD-Attribute; leere optionale Werte werden weggelassen
+func bridgeDomainAttributes(p v1alpha1.BridgeDomainParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"unicastRoute":          p.UnicastRoute,
+		"arpFlood":              p.ArpFlood,
+		"unkMacUcastAct":        p.UnkMacUcastAct,
+		"unkMcastAct":           p.UnkMcastAct,
+		"multiDstPktAct":        p.MultiDstPktAct,
+		"limitIpLearnToSubnets": p.LimitIPLearnToSubnets,
+	})
+}
+
+// bridgeDomainPayload baut die fvBD-Payload auf. observed enthält die aktuellen Kindobjekte,
+// damit nicht mehr gewünschte L3Out-Relationen entfernt werden können.
+func bridgeDomainPayload(p v1alpha1.BridgeDomainParameters, status string, observed []ManagedObject) map[string]interface{} {
+	attrs := bridgeDomainAttributes(p)
+	attrs["dn"] = BridgeDomainDN(p.Tenant, p.Name)
+	attrs["status"] = status
+
+	children := []interface{}{
+		map[string]interface{}{
+			"fvRsCtx": map[string]interface{}{
+				"attributes": map[string]string{
+					"tnFvCtxName": p.Vrf,
+					"status":      "created,modified",
+				},
+			},
+		},
+	}
+	children = append(children, relationChildren("fvRsBDToOut", "tnL3extOutName", p.L3Outs, observed)...)
+
+	return map[string]interface{}{
+		"fvBD": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateBridgeDomain erstellt eine neue Bridge Domain in Cisco ACI
+func (c *BridgeDomainClient) CreateBridgeDomain(p v1alpha1.BridgeDomainParameters) error {
+	if err := c.client.PostMO(BridgeDomainDN(p.Tenant, p.Name), bridgeDomainPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der BridgeDomain: %v", err)
+	}
+
+	log.Println("BridgeDomain erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateBridgeDomain aktualisiert eine bestehende Bridge Domain in Cisco ACI
+func (c *BridgeDomainClient) UpdateBridgeDomain(p v1alpha1.BridgeDomainParameters) error {
+	current, err := c.ObserveBridgeDomain(p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+	var observed []ManagedObject
+	if current != nil {
+		observed = current.Children
+	}
+
+	if err := c.client.PostMO(BridgeDomainDN(p.Tenant, p.Name), bridgeDomainPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der BridgeDomain: %v", err)
+	}
+
+	log.Println("BridgeDomain erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteBridgeDomain löscht eine bestehende Bridge Domain in Cisco ACI
+func (c *BridgeDomainClient) DeleteBridgeDomain(tenant, name string) error {
+	data := map[string]interface{}{
+		"fvBD": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(BridgeDomainDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der BridgeDomain: %v", err)
+	}
+
+	log.Println("BridgeDomain erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveBridgeDomain liest eine Bridge Domain samt Relationen aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *BridgeDomainClient) ObserveBridgeDomain(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(BridgeDomainDN(tenant, name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der BridgeDomain: %w", err)
+	}
+	return mo, nil
+}
+
+// BridgeDomainUpToDate prüft, ob die beobachtete Bridge Domain der Spezifikation entspricht
+func BridgeDomainUpToDate(mo *ManagedObject, p v1alpha1.BridgeDomainParameters) bool {
+	if !attributesMatch(mo.Attributes, bridgeDomainAttributes(p)) {
+		return false
+	}
+	if rs := mo.Child("fvRsCtx"); rs == nil || rs.Attributes["tnFvCtxName"] != p.Vrf {
+		return false
+	}
+	return relationsMatch("fvRsBDToOut", "tnL3extOutName", p.L3Outs, mo.Children)
+}
@@ -0,0 +1,185 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// BridgeDomainSubnetClient verwaltet Operationen für Subnetze (fvSubnet) einer Bridge Domain in Cisco ACI
+type BridgeDomainSubnetClient struct {
+	client *Client
+}
+
+// NewBridgeDomainSubnetClient initialisiert einen neuen BridgeDomainSubnet-Client
+func NewBridgeDomainSubnetClient(client *Client) *BridgeDomainSubnetClient {
+	return &BridgeDomainSubnetClient{
+		client: client,
+	}
+}
+
+// BridgeDomainSubnetDN liefert den DN eines Subnetzes unterhalb einer Bridge Domain
+func BridgeDomainSubnetDN(tenant, bd, ip string) string {
+	return fmt.Sprintf("%s/subnet-[%s]", BridgeDomainDN(tenant, bd), ip)
+}
+
+// ValidateBridgeDomainSubnet prüft die Gateway-Adresse und den Scope, bevor sie an die APIC gesendet werden
+func ValidateBridgeDomainSubnet(p v1alpha1.BridgeDomainSubnetParameters) error {
+	if _, _, err := net.ParseCIDR(p.IP); err != nil {
+		return fmt.Errorf("ungültige Gateway-Adresse %q, erwartet wird IP/Präfixlänge: %v", p.IP, err)
+	}
+
+	seen := map[string]bool{}
+	for _, scope := range p.Scope {
+		switch scope {
+		case "private", "public", "shared":
+		default:
+			return fmt.Errorf("ungültiger Scope %q, erlaubt sind private, public und shared", scope)
+		}
+		seen[scope] = true
+	}
+	if seen["private"] && seen["public"] {
+		return fmt.Errorf("die Scopes private und public schließen sich gegenseitig aus")
+	}
+	return nil
+}
+
+// subnetScope liefert den Scope im Format der APIC (sortiert, kommagetrennt)
+func subnetScope(scope []string) string {
+	sorted := append([]string(nil), scope...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// subnetCtrl liefert das ctrl-Attribut des Subnetzes. observed ist das aktuelle ctrl-Attribut;
+// Flags, die die Spezifikation nicht verwaltet (z.B. querier), bleiben erhalten.
+func subnetCtrl(noDefaultGateway bool, observed string) string {
+	flags := []string{"nd"}
+	if noDefaultGateway {
+		flags = []string{"no-default-gateway"}
+	}
+	for _, flag := range strings.Split(observed, ",") {
+		switch flag {
+		case "", "unspecified", "nd", "no-default-gateway":
+		default:
+			flags = append(flags, flag)
+		}
+	}
+	sort.Strings(flags)
+	return strings.Join(flags, ",")
+}
+
+// bridgeDomainSubnetAttributes liefert die konfigurierbaren fvSubnet-Attribute; leere optionale Werte werden weggelassen.
+// Ohne Scope wird der Default der APIC (private) gesendet, damit entfernte Scopes zurückgesetzt werden.
+func bridgeDomainSubnetAttributes(p v1alpha1.BridgeDomainSubnetParameters) map[string]string {
+	scope := subnetScope(p.Scope)
+	if scope == "" {
+		scope = "private"
+	}
+	return withAttributes(map[string]string{
+		"ip":    p.IP,
+		"descr": p.Desc,
+		"scope": scope,
+	}, map[string]string{
+		"preferred": p.Preferred,
+		"virtual":   p.Virtual,
+	})
+}
+
+// bridgeDomainSubnetPayload baut die fvSubnet-Payload auf. observed ist das aktuelle Subnetz oder nil,
+// damit eine entfernte ND-Prefix-Relation gelöscht wird und nicht verwaltete ctrl-Flags erhalten bleiben.
+func bridgeDomainSubnetPayload(p v1alpha1.BridgeDomainSubnetParameters, status string, observed *ManagedObject) map[string]interface{} {
+	attrs := bridgeDomainSubnetAttributes(p)
+	attrs["dn"] = BridgeDomainSubnetDN(p.Tenant, p.Bd, p.IP)
+	attrs["status"] = status
+
+	var currentCtrl string
+	if observed != nil {
+		currentCtrl = observed.Attributes["ctrl"]
+	}
+	attrs["ctrl"] = subnetCtrl(p.NoDefaultGateway, currentCtrl)
+
+	children := relationChildren("fvRsNdPfxPol", "tnNdPfxPolName", optionalList(p.NdRaPrefixPolicy), observed.ChildrenOf("fvRsNdPfxPol"))
+
+	return map[string]interface{}{
+		"fvSubnet": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateBridgeDomainSubnet erstellt ein neues Subnetz in einer Bridge Domain
+func (c *BridgeDomainSubnetClient) CreateBridgeDomainSubnet(p v1alpha1.BridgeDomainSubnetParameters) error {
+	if err := ValidateBridgeDomainSubnet(p); err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(BridgeDomainSubnetDN(p.Tenant, p.Bd, p.IP), bridgeDomainSubnetPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des BridgeDomainSubnets: %v", err)
+	}
+
+	log.Println("BridgeDomainSubnet erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateBridgeDomainSubnet aktualisiert ein bestehendes Subnetz in einer Bridge Domain
+func (c *BridgeDomainSubnetClient) UpdateBridgeDomainSubnet(p v1alpha1.BridgeDomainSubnetParameters) error {
+	if err := ValidateBridgeDomainSubnet(p); err != nil {
+		return err
+	}
+
+	current, err := c.ObserveBridgeDomainSubnet(p.Tenant, p.Bd, p.IP)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(BridgeDomainSubnetDN(p.Tenant, p.Bd, p.IP), bridgeDomainSubnetPayload(p, "modified", current)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des BridgeDomainSubnets: %v", err)
+	}
+
+	log.Println("BridgeDomainSubnet erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteBridgeDomainSubnet löscht ein bestehendes Subnetz aus einer Bridge Domain
+func (c *BridgeDomainSubnetClient) DeleteBridgeDomainSubnet(tenant, bd, ip string) error {
+	data := map[string]interface{}{
+		"fvSubnet": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(BridgeDomainSubnetDN(tenant, bd, ip), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des BridgeDomainSubnets: %v", err)
+	}
+
+	log.Println("BridgeDomainSubnet erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveBridgeDomainSubnet liest ein Subnetz samt Relationen aus Cisco ACI. Gibt nil zurück, wenn es nicht existiert.
+func (c *BridgeDomainSubnetClient) ObserveBridgeDomainSubnet(tenant, bd, ip string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(BridgeDomainSubnetDN(tenant, bd, ip), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des BridgeDomainSubnets: %w", err)
+	}
+	return mo, nil
+}
+
+// BridgeDomainSubnetUpToDate prüft, ob das beobachtete Subnetz der Spezifikation entspricht
+func BridgeDomainSubnetUpToDate(mo *ManagedObject, p v1alpha1.BridgeDomainSubnetParameters) bool {
+	if !flagsMatch(mo.Attributes, bridgeDomainSubnetAttributes(p), "scope") {
+		return false
+	}
+	if strings.Contains(mo.Attributes["ctrl"], "no-default-gateway") != p.NoDefaultGateway {
+		return false
+	}
+	return relationsMatch("fvRsNdPfxPol", "tnNdPfxPolName", optionalList(p.NdRaPrefixPolicy), mo.Children)
+}
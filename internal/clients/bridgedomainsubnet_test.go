@@ -0,0 +1,165 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestSubnetScope(t *testing.T) {
+	cases := map[string]struct {
+		scope []string
+		want  string
+	}{
+		"Empty":    {scope: nil, want: ""},
+		"Single":   {scope: []string{"private"}, want: "private"},
+		"Sorted":   {scope: []string{"public", "shared"}, want: "public,shared"},
+		"Unsorted": {scope: []string{"shared", "public"}, want: "public,shared"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := subnetScope(tc.scope); got != tc.want {
+				t.Errorf("subnetScope(%v) = %q, want %q", tc.scope, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBridgeDomainSubnetUpToDate(t *testing.T) {
+	p := v1alpha1.BridgeDomainSubnetParameters{
+		Tenant: "tn1",
+		Bd:     "bd1",
+		IP:     "10.0.0.1/24",
+		Scope:  []string{"shared", "public"},
+	}
+
+	cases := map[string]struct {
+		mo   *ManagedObject
+		p    v1alpha1.BridgeDomainSubnetParameters
+		want bool
+	}{
+		"ScopeInAPICOrder": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "public,shared", "ctrl": "nd"},
+			},
+			p:    p,
+			want: true,
+		},
+		"ScopeInOtherOrder": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "shared,public", "ctrl": "nd"},
+			},
+			p:    p,
+			want: true,
+		},
+		"ScopeDiffers": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "private", "ctrl": "nd"},
+			},
+			p:    p,
+			want: false,
+		},
+		"ScopeRemovedFromSpec": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "public", "ctrl": "nd"},
+			},
+			p:    v1alpha1.BridgeDomainSubnetParameters{Tenant: "tn1", Bd: "bd1", IP: "10.0.0.1/24"},
+			want: false,
+		},
+		"DefaultScope": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "private", "ctrl": "nd"},
+			},
+			p:    v1alpha1.BridgeDomainSubnetParameters{Tenant: "tn1", Bd: "bd1", IP: "10.0.0.1/24"},
+			want: true,
+		},
+		"RemovedPrefixPolicy": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "public,shared", "ctrl": "nd"},
+				Children: []ManagedObject{
+					{Class: "fvRsNdPfxPol", Attributes: map[string]string{"tnNdPfxPolName": "old"}},
+				},
+			},
+			p:    p,
+			want: false,
+		},
+		"DefaultPrefixPolicyRelation": {
+			mo: &ManagedObject{
+				Class:      "fvSubnet",
+				Attributes: map[string]string{"ip": "10.0.0.1/24", "scope": "public,shared", "ctrl": "nd"},
+				Children: []ManagedObject{
+					{Class: "fvRsNdPfxPol", Attributes: map[string]string{"tnNdPfxPolName": ""}},
+				},
+			},
+			p:    p,
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := BridgeDomainSubnetUpToDate(tc.mo, tc.p); got != tc.want {
+				t.Errorf("BridgeDomainSubnetUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBridgeDomainSubnetPayloadAttributes(t *testing.T) {
+	cases := map[string]struct {
+		p         v1alpha1.BridgeDomainSubnetParameters
+		observed  *ManagedObject
+		wantScope string
+		wantCtrl  string
+	}{
+		"Create": {
+			p:         v1alpha1.BridgeDomainSubnetParameters{IP: "10.0.0.1/24", Scope: []string{"shared", "public"}},
+			wantScope: "public,shared",
+			wantCtrl:  "nd",
+		},
+		"EmptyScope": {
+			p:         v1alpha1.BridgeDomainSubnetParameters{IP: "10.0.0.1/24"},
+			observed:  &ManagedObject{Class: "fvSubnet", Attributes: map[string]string{"scope": "public", "ctrl": "nd"}},
+			wantScope: "private",
+			wantCtrl:  "nd",
+		},
+		"KeepsQuerier": {
+			p:         v1alpha1.BridgeDomainSubnetParameters{IP: "10.0.0.1/24", NoDefaultGateway: true},
+			observed:  &ManagedObject{Class: "fvSubnet", Attributes: map[string]string{"ctrl": "nd,querier"}},
+			wantScope: "private",
+			wantCtrl:  "no-default-gateway,querier",
+		},
+		"ReenablesGateway": {
+			p:         v1alpha1.BridgeDomainSubnetParameters{IP: "10.0.0.1/24"},
+			observed:  &ManagedObject{Class: "fvSubnet", Attributes: map[string]string{"ctrl": "querier,no-default-gateway"}},
+			wantScope: "private",
+			wantCtrl:  "nd,querier",
+		},
+		"UnspecifiedCtrl": {
+			p:         v1alpha1.BridgeDomainSubnetParameters{IP: "10.0.0.1/24"},
+			observed:  &ManagedObject{Class: "fvSubnet", Attributes: map[string]string{"ctrl": "unspecified"}},
+			wantScope: "private",
+			wantCtrl:  "nd",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			payload := bridgeDomainSubnetPayload(tc.p, "modified", tc.observed)
+			attrs := payload["fvSubnet"].(map[string]interface{})["attributes"].(map[string]string)
+			if attrs["scope"] != tc.wantScope {
+				t.Errorf("scope = %q, want %q", attrs["scope"], tc.wantScope)
+			}
+			if attrs["ctrl"] != tc.wantCtrl {
+				t.Errorf("ctrl = %q, want %q", attrs["ctrl"], tc.wantCtrl)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package clients
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// CDPPolicyClient verwaltet Operationen für CDP-Interface-Policies (cdpIfPol) in Cisco ACI
+type CDPPolicyClient struct {
+	policy *policyClient[v1alpha1.CDPPolicyParameters]
+}
+
+// NewCDPPolicyClient initialisiert einen neuen CDPPolicy-Client
+func NewCDPPolicyClient(client *Client) *CDPPolicyClient {
+	return &CDPPolicyClient{
+		policy: &policyClient[v1alpha1.CDPPolicyParameters]{
+			client:     client,
+			class:      "cdpIfPol",
+			label:      "CDP-Policy",
+			dn:         CDPPolicyDN,
+			attributes: cdpPolicyAttributes,
+		},
+	}
+}
+
+// CDPPolicyDN liefert den DN einer CDP-Policy
+func CDPPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/cdpIfP-%s", name)
+}
+
+// cdpPolicyAttributes liefert die konfigurierbaren cdpIfPol-Attribute; leere optionale Werte werden weggelassen
+func cdpPolicyAttributes(p v1alpha1.CDPPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"adminSt": p.AdminState,
+	})
+}
+
+// CreateCDPPolicy erstellt eine neue CDP-Policy in Cisco ACI
+func (c *CDPPolicyClient) CreateCDPPolicy(p v1alpha1.CDPPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateCDPPolicy aktualisiert eine bestehende CDP-Policy in Cisco ACI
+func (c *CDPPolicyClient) UpdateCDPPolicy(p v1alpha1.CDPPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteCDPPolicy löscht eine bestehende CDP-Policy in Cisco ACI
+func (c *CDPPolicyClient) DeleteCDPPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveCDPPolicy liest eine CDP-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *CDPPolicyClient) ObserveCDPPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// CDPPolicyUpToDate prüft, ob die beobachtete CDP-Policy der Spezifikation entspricht
+func CDPPolicyUpToDate(mo *ManagedObject, p v1alpha1.CDPPolicyParameters) bool {
+	return attributesMatch(mo.Attributes, cdpPolicyAttributes(p))
+}
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 )
 
 // Client repräsentiert den API-Client für die Kommunikation mit Cisco ACI
@@ -155,3 +157,247 @@ func (c *Client) DoRequest(method, endpoint string, data interface{}) ([]byte, e
 	return body, nil
 }
 
+// ManagedObject repräsentiert ein Objekt aus dem ACI Management Information Tree
+type ManagedObject struct {
+	Class      string
+	Attributes map[string]string
+	Children   []ManagedObject
+}
+
+// moBody entspricht dem JSON-Format eines Objekts in der ACI-Antwort
+type moBody struct {
+	Attributes map[string]string   `json:"attributes"`
+	Children   []map[string]moBody `json:"children"`
+}
+
+func toManagedObjects(raw []map[string]moBody) []ManagedObject {
+	objects := make([]ManagedObject, 0, len(raw))
+	for _, entry := range raw {
+		for class, body := range entry {
+			objects = append(objects, ManagedObject{
+				Class:      class,
+				Attributes: body.Attributes,
+				Children:   toManagedObjects(body.Children),
+			})
+		}
+	}
+	return objects
+}
+
+// parseResponse liest die imdata einer ACI-Antwort und gibt einen Fehler zurück, wenn die API einen meldet
+func parseResponse(body []byte) ([]ManagedObject, error) {
+	var result struct {
+		Imdata []map[string]moBody `json:"imdata"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("Fehler beim Unmarshalen der Antwort: %v", err)
+	}
+	objects := toManagedObjects(result.Imdata)
+	for _, mo := range objects {
+		if mo.Class == "error" {
+			return nil, fmt.Errorf("code=%s, text=%s", mo.Attributes["code"], mo.Attributes["text"])
+		}
+	}
+	return objects, nil
+}
+
+// PostMO sendet die Payload an den angegebenen DN und prüft die Antwort auf API-Fehler
+func (c *Client) PostMO(dn string, data interface{}) error {
+	url := fmt.Sprintf("/api/node/mo/%s.json", dn)
+
+	log.Printf("Sende POST-Anfrage an %s mit Daten: %v\n", url, data)
+
+	respBody, err := c.DoRequest("POST", url, data)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Antwort: %s\n", string(respBody))
+
+	_, err = parseResponse(respBody)
+	return err
+}
+
+// GetMO liest das Objekt mit dem angegebenen DN. Gibt nil zurück, wenn es nicht existiert.
+// query wird unverändert an die URL angehängt, z.B. "rsp-subtree=children".
+func (c *Client) GetMO(dn, query string) (*ManagedObject, error) {
+	url := fmt.Sprintf("/api/node/mo/%s.json", dn)
+	if query != "" {
+		url = fmt.Sprintf("%s?%s", url, query)
+	}
+
+	respBody, err := c.DoRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := parseResponse(respBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, nil
+	}
+	return &objects[0], nil
+}
+
+// Child liefert das erste Kindobjekt der angegebenen Klasse oder nil
+func (mo *ManagedObject) Child(class string) *ManagedObject {
+	if mo == nil {
+		return nil
+	}
+	for i := range mo.Children {
+		if mo.Children[i].Class == class {
+			return &mo.Children[i]
+		}
+	}
+	return nil
+}
+
+// ChildBy liefert das Kindobjekt der angegebenen Klasse, dessen Attribut key den Wert value hat, oder nil
+func (mo *ManagedObject) ChildBy(class, key, value string) *ManagedObject {
+	if mo == nil {
+		return nil
+	}
+	for i := range mo.Children {
+		if mo.Children[i].Class == class && mo.Children[i].Attributes[key] == value {
+			return &mo.Children[i]
+		}
+	}
+	return nil
+}
+
+// ChildrenOf liefert alle Kindobjekte der angegebenen Klasse
+func (mo *ManagedObject) ChildrenOf(class string) []ManagedObject {
+	if mo == nil {
+		return nil
+	}
+	var children []ManagedObject
+	for _, child := range mo.Children {
+		if child.Class == class {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// attributesMatch prüft, ob alle gewünschten Attribute mit den beobachteten übereinstimmen
+func attributesMatch(observed, desired map[string]string) bool {
+	for key, value := range desired {
+		if observed[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// flagsMatch vergleicht wie attributesMatch, behandelt die Attribute in flags aber als kommagetrennte
+// Listen, deren Reihenfolge der APIC nicht garantiert. Die gewünschten Werte müssen bereits sortiert sein.
+func flagsMatch(observed, desired map[string]string, flags ...string) bool {
+	rest := make(map[string]string, len(desired))
+	for key, value := range desired {
+		rest[key] = value
+	}
+	for _, key := range flags {
+		value, ok := rest[key]
+		if !ok {
+			continue
+		}
+		current := strings.Split(observed[key], ",")
+		sort.Strings(current)
+		if strings.Join(current, ",") != value {
+			return false
+		}
+		delete(rest, key)
+	}
+	return attributesMatch(observed, rest)
+}
+
+// withAttributes kopiert attrs und ergänzt die übergebenen Werte, sofern sie nicht leer sind
+func withAttributes(attrs map[string]string, optional map[string]string) map[string]string {
+	out := make(map[string]string, len(attrs)+len(optional))
+	for key, value := range attrs {
+		out[key] = value
+	}
+	for key, value := range optional {
+		if value != "" {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// relationChildren liefert die Kindobjekte, mit denen eine Liste von Relationen der Klasse class
+// auf den gewünschten Stand gebracht wird: fehlende Relationen werden angelegt, nicht mehr
+// gewünschte gelöscht. nameAttr ist das Attribut, das das Ziel der Relation benennt.
+func relationChildren(class, nameAttr string, desired []string, observed []ManagedObject) []interface{} {
+	children := []interface{}{}
+	wanted := make(map[string]bool, len(desired))
+	// Relationen ohne Ziel legt der APIC selbst an (Default-Policy), sie werden nicht gelöscht
+	wanted[""] = true
+	for _, name := range desired {
+		wanted[name] = true
+		children = append(children, map[string]interface{}{
+			class: map[string]interface{}{
+				"attributes": map[string]string{
+					nameAttr: name,
+					"status": "created,modified",
+				},
+			},
+		})
+	}
+	return append(children, deletedChildren(class, nameAttr, wanted, observed)...)
+}
+
+// deletedChildren liefert Lösch-Einträge für alle beobachteten Objekte der Klasse class,
+// deren Schlüsselattribut key nicht in wanted enthalten ist. naming sind weitere Attribute,
+// die der APIC neben key zur Bildung des RN benötigt, z.B. type bei Selektoren.
+func deletedChildren(class, key string, wanted map[string]bool, observed []ManagedObject, naming ...string) []interface{} {
+	children := []interface{}{}
+	for _, mo := range observed {
+		if mo.Class != class || wanted[mo.Attributes[key]] {
+			continue
+		}
+		attrs := map[string]string{
+			key:      mo.Attributes[key],
+			"status": "deleted",
+		}
+		for _, attr := range naming {
+			attrs[attr] = mo.Attributes[attr]
+		}
+		children = append(children, map[string]interface{}{
+			class: map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	return children
+}
+
+// optionalList liefert eine optionale einzelne Relation als Liste für relationChildren und relationsMatch
+func optionalList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+// relationsMatch prüft, ob die beobachteten Relationen der Klasse class genau den gewünschten entsprechen.
+// Relationen ohne Ziel werden wie fehlende behandelt.
+func relationsMatch(class, nameAttr string, desired []string, observed []ManagedObject) bool {
+	current := map[string]bool{}
+	for _, mo := range observed {
+		if mo.Class == class && mo.Attributes[nameAttr] != "" {
+			current[mo.Attributes[nameAttr]] = true
+		}
+	}
+	if len(current) != len(desired) {
+		return false
+	}
+	for _, name := range desired {
+		if !current[name] {
+			return false
+		}
+	}
+	return true
+}
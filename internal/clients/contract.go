@@ -0,0 +1,272 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// subjectTerms sind die Richtungs-Terme eines Subjects, wenn die Filter nicht in beide Richtungen gelten
+var subjectTerms = []string{"vzInTerm", "vzOutTerm"}
+
+// ContractClient verwaltet Operationen für Contracts (vzBrCP) und deren Subjects in Cisco ACI
+type ContractClient struct {
+	client *Client
+}
+
+// NewContractClient initialisiert einen neuen Contract-Client
+func NewContractClient(client *Client) *ContractClient {
+	return &ContractClient{
+		client: client,
+	}
+}
+
+// ContractDN liefert den DN eines Contracts
+func ContractDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/brc-%s", tenant, name)
+}
+
+// contractAttributes liefert die konfigurierbaren vzBrCP-Attribute; leere optionale Werte werden weggelassen
+func contractAttributes(p v1alpha1.ContractParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"scope": p.Scope,
+		"prio":  p.Prio,
+	})
+}
+
+// contractSubjectAttributes liefert die konfigurierbaren vzSubj-Attribute
+func contractSubjectAttributes(s v1alpha1.ContractSubject) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  s.Name,
+		"descr": s.Desc,
+	}, map[string]string{
+		"revFltPorts": s.ReverseFilterPorts,
+	})
+}
+
+// appliesBothDirections gibt an, ob die Filter des Subjects in beide Richtungen gelten (Default)
+func appliesBothDirections(s v1alpha1.ContractSubject) bool {
+	return s.ApplyBothDirections != "no"
+}
+
+// contractSubjectPayload baut die vzSubj-Payload auf. observed ist das aktuelle Subject oder nil,
+// damit nicht mehr gewünschte Filter-Relationen und Terme entfernt werden können.
+func contractSubjectPayload(s v1alpha1.ContractSubject, observed *ManagedObject) map[string]interface{} {
+	var observedChildren []ManagedObject
+	if observed != nil {
+		observedChildren = observed.Children
+	}
+
+	attrs := contractSubjectAttributes(s)
+	attrs["status"] = "created,modified"
+
+	var children []interface{}
+	if appliesBothDirections(s) {
+		children = relationChildren("vzRsSubjFiltAtt", "tnVzFilterName", s.Filters, observedChildren)
+		for _, term := range subjectTerms {
+			if observed != nil && observed.Child(term) != nil {
+				children = append(children, map[string]interface{}{
+					term: map[string]interface{}{
+						"attributes": map[string]string{"status": "deleted"},
+					},
+				})
+			}
+		}
+	} else {
+		children = relationChildren("vzRsSubjFiltAtt", "tnVzFilterName", nil, observedChildren)
+		for _, term := range subjectTerms {
+			var termChildren []ManagedObject
+			if observed != nil {
+				if t := observed.Child(term); t != nil {
+					termChildren = t.Children
+				}
+			}
+			children = append(children, map[string]interface{}{
+				term: map[string]interface{}{
+					"attributes": map[string]string{"status": "created,modified"},
+					"children":   relationChildren("vzRsFiltAtt", "tnVzFilterName", s.Filters, termChildren),
+				},
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"vzSubj": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// contractPayload baut die vzBrCP-Payload inklusive aller Subjects auf. observed ist der
+// aktuelle Contract oder nil; nicht mehr gewünschte Subjects werden gelöscht.
+func contractPayload(p v1alpha1.ContractParameters, status string, observed *ManagedObject) map[string]interface{} {
+	attrs := contractAttributes(p)
+	attrs["dn"] = ContractDN(p.Tenant, p.Name)
+	attrs["status"] = status
+
+	observedSubjects := map[string]*ManagedObject{}
+	if observed != nil {
+		for i := range observed.Children {
+			if observed.Children[i].Class == "vzSubj" {
+				observedSubjects[observed.Children[i].Attributes["name"]] = &observed.Children[i]
+			}
+		}
+	}
+
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, s := range p.Subjects {
+		wanted[s.Name] = true
+		children = append(children, contractSubjectPayload(s, observedSubjects[s.Name]))
+	}
+	for name := range observedSubjects {
+		if !wanted[name] {
+			children = append(children, map[string]interface{}{
+				"vzSubj": map[string]interface{}{
+					"attributes": map[string]string{
+						"name":   name,
+						"status": "deleted",
+					},
+				},
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"vzBrCP": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateContract erstellt einen neuen Contract samt Subjects in Cisco ACI
+func (c *ContractClient) CreateContract(p v1alpha1.ContractParameters) error {
+	if err := c.client.PostMO(ContractDN(p.Tenant, p.Name), contractPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des Contracts: %v", err)
+	}
+
+	log.Println("Contract erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateContract aktualisiert einen bestehenden Contract samt Subjects in Cisco ACI
+func (c *ContractClient) UpdateContract(p v1alpha1.ContractParameters) error {
+	observed, err := c.ObserveContract(p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(ContractDN(p.Tenant, p.Name), contractPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des Contracts: %v", err)
+	}
+
+	log.Println("Contract erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteContract löscht einen bestehenden Contract in Cisco ACI
+func (c *ContractClient) DeleteContract(tenant, name string) error {
+	data := map[string]interface{}{
+		"vzBrCP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(ContractDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des Contracts: %v", err)
+	}
+
+	log.Println("Contract erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveContract liest einen Contract samt Subjects und Filter-Relationen aus Cisco ACI.
+// Gibt nil zurück, wenn er nicht existiert.
+func (c *ContractClient) ObserveContract(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(ContractDN(tenant, name), "rsp-subtree=full")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des Contracts: %w", err)
+	}
+	return mo, nil
+}
+
+// contractSubjectUpToDate prüft, ob ein beobachtetes Subject der Spezifikation entspricht
+func contractSubjectUpToDate(mo *ManagedObject, s v1alpha1.ContractSubject) bool {
+	if !attributesMatch(mo.Attributes, contractSubjectAttributes(s)) {
+		return false
+	}
+	if appliesBothDirections(s) {
+		return relationsMatch("vzRsSubjFiltAtt", "tnVzFilterName", s.Filters, mo.Children)
+	}
+	for _, term := range subjectTerms {
+		t := mo.Child(term)
+		if t == nil || !relationsMatch("vzRsFiltAtt", "tnVzFilterName", s.Filters, t.Children) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContractUpToDate prüft, ob der beobachtete Contract samt Subjects der Spezifikation entspricht
+func ContractUpToDate(mo *ManagedObject, p v1alpha1.ContractParameters) bool {
+	if !attributesMatch(mo.Attributes, contractAttributes(p)) {
+		return false
+	}
+
+	subjects := mo.ChildrenOf("vzSubj")
+	if len(subjects) != len(p.Subjects) {
+		return false
+	}
+	for _, s := range p.Subjects {
+		found := false
+		for i := range subjects {
+			if subjects[i].Attributes["name"] == s.Name {
+				found = contractSubjectUpToDate(&subjects[i], s)
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// contractRelationClasses ordnet die Relationsklassen eines Endpoint Groups ihrem Namensattribut zu
+var contractRelationClasses = []struct {
+	class, nameAttr string
+	names           func(v1alpha1.ContractRelations) []string
+}{
+	{"fvRsProv", "tnVzBrCPName", func(r v1alpha1.ContractRelations) []string { return r.Provided }},
+	{"fvRsCons", "tnVzBrCPName", func(r v1alpha1.ContractRelations) []string { return r.Consumed }},
+	{"fvRsConsIf", "tnVzCPIfName", func(r v1alpha1.ContractRelations) []string { return r.ConsumedInterfaces }},
+	{"fvRsProtBy", "tnVzTabooName", func(r v1alpha1.ContractRelations) []string { return r.Taboo }},
+}
+
+// contractRelationChildren liefert die Kindobjekte, mit denen die Contract-Relationen eines
+// Endpoint Groups auf den gewünschten Stand gebracht werden
+func contractRelationChildren(r v1alpha1.ContractRelations, observed []ManagedObject) []interface{} {
+	children := []interface{}{}
+	for _, rel := range contractRelationClasses {
+		children = append(children, relationChildren(rel.class, rel.nameAttr, rel.names(r), observed)...)
+	}
+	return children
+}
+
+// contractRelationsMatch prüft, ob die beobachteten Contract-Relationen der Spezifikation entsprechen
+func contractRelationsMatch(r v1alpha1.ContractRelations, observed []ManagedObject) bool {
+	for _, rel := range contractRelationClasses {
+		if !relationsMatch(rel.class, rel.nameAttr, rel.names(r), observed) {
+			return false
+		}
+	}
+	return true
+}
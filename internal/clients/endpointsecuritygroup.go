@@ -0,0 +1,224 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// EndpointSecurityGroupClient verwaltet Operationen für Endpoint Security Groups (fvESg) in Cisco ACI
+type EndpointSecurityGroupClient struct {
+	client *Client
+}
+
+// NewEndpointSecurityGroupClient initialisiert einen neuen EndpointSecurityGroup-Client
+func NewEndpointSecurityGroupClient(client *Client) *EndpointSecurityGroupClient {
+	return &EndpointSecurityGroupClient{
+		client: client,
+	}
+}
+
+// EndpointSecurityGroupDN liefert den DN einer Endpoint Security Group
+func EndpointSecurityGroupDN(tenant, appProfile, name string) string {
+	return fmt.Sprintf("%s/esg-%s", ApplicationProfileDN(tenant, appProfile), name)
+}
+
+// ipSelectorExpression liefert den matchExpression eines IP-Subnetz-Selektors
+func ipSelectorExpression(subnet string) string {
+	return fmt.Sprintf("ip=='%s'", subnet)
+}
+
+// ValidateEndpointSecurityGroup prüft Selektoren und Contracts, bevor sie an die APIC gesendet werden
+func ValidateEndpointSecurityGroup(p v1alpha1.EndpointSecurityGroupParameters) error {
+	for _, subnet := range p.IPSubnetSelectors {
+		if _, _, err := net.ParseCIDR(subnet); err != nil {
+			return fmt.Errorf("ungültiges Subnetz %q im IP-Selektor: %v", subnet, err)
+		}
+	}
+	if len(p.Contracts.Taboo) > 0 {
+		return fmt.Errorf("Taboo-Contracts werden von Endpoint Security Groups nicht unterstützt")
+	}
+	return nil
+}
+
+// tagSelectorAttributes liefert die Attribute eines fvTagSelector
+func tagSelectorAttributes(tag v1alpha1.TagSelector) map[string]string {
+	return withAttributes(map[string]string{
+		"matchKey":   tag.Key,
+		"matchValue": tag.Value,
+	}, map[string]string{
+		"valueOperator": tag.Operator,
+	})
+}
+
+// tagSelectorChildren liefert die fvTagSelector-Kindobjekte; nicht mehr gewünschte Selektoren werden gelöscht
+func tagSelectorChildren(tags []v1alpha1.TagSelector, observed []ManagedObject) []interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, tag := range tags {
+		wanted[tag.Key+"="+tag.Value] = true
+		attrs := tagSelectorAttributes(tag)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"fvTagSelector": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	for _, mo := range observed {
+		if mo.Class != "fvTagSelector" || wanted[mo.Attributes["matchKey"]+"="+mo.Attributes["matchValue"]] {
+			continue
+		}
+		children = append(children, map[string]interface{}{
+			"fvTagSelector": map[string]interface{}{
+				"attributes": map[string]string{
+					"matchKey":   mo.Attributes["matchKey"],
+					"matchValue": mo.Attributes["matchValue"],
+					"status":     "deleted",
+				},
+			},
+		})
+	}
+	return children
+}
+
+// endpointSecurityGroupPayload baut die fvESg-Payload inklusive Selektoren und Relationen auf.
+// observed ist die aktuelle ESG oder nil; nicht mehr gewünschte Selektoren und Relationen werden gelöscht.
+func endpointSecurityGroupPayload(p v1alpha1.EndpointSecurityGroupParameters, status string, observed *ManagedObject) map[string]interface{} {
+	var observedChildren []ManagedObject
+	if observed != nil {
+		observedChildren = observed.Children
+	}
+
+	expressions := make([]string, 0, len(p.IPSubnetSelectors))
+	for _, subnet := range p.IPSubnetSelectors {
+		expressions = append(expressions, ipSelectorExpression(subnet))
+	}
+
+	children := []interface{}{
+		map[string]interface{}{
+			"fvRsScope": map[string]interface{}{
+				"attributes": map[string]string{
+					"tnFvCtxName": p.Vrf,
+					"status":      "created,modified",
+				},
+			},
+		},
+	}
+	children = append(children, relationChildren("fvEPgSelector", "matchEpgDn", p.EPGSelectors, observedChildren)...)
+	children = append(children, relationChildren("fvEPSelector", "matchExpression", expressions, observedChildren)...)
+	children = append(children, tagSelectorChildren(p.TagSelectors, observedChildren)...)
+	children = append(children, contractRelationChildren(p.Contracts, observedChildren)...)
+
+	return map[string]interface{}{
+		"fvESg": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     EndpointSecurityGroupDN(p.Tenant, p.AppProfile, p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateEndpointSecurityGroup erstellt eine neue Endpoint Security Group in Cisco ACI
+func (c *EndpointSecurityGroupClient) CreateEndpointSecurityGroup(p v1alpha1.EndpointSecurityGroupParameters) error {
+	if err := ValidateEndpointSecurityGroup(p); err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(EndpointSecurityGroupDN(p.Tenant, p.AppProfile, p.Name), endpointSecurityGroupPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der EndpointSecurityGroup: %v", err)
+	}
+
+	log.Println("EndpointSecurityGroup erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateEndpointSecurityGroup aktualisiert eine bestehende Endpoint Security Group in Cisco ACI
+func (c *EndpointSecurityGroupClient) UpdateEndpointSecurityGroup(p v1alpha1.EndpointSecurityGroupParameters) error {
+	if err := ValidateEndpointSecurityGroup(p); err != nil {
+		return err
+	}
+
+	observed, err := c.ObserveEndpointSecurityGroup(p.Tenant, p.AppProfile, p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(EndpointSecurityGroupDN(p.Tenant, p.AppProfile, p.Name), endpointSecurityGroupPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der EndpointSecurityGroup: %v", err)
+	}
+
+	log.Println("EndpointSecurityGroup erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteEndpointSecurityGroup löscht eine bestehende Endpoint Security Group in Cisco ACI
+func (c *EndpointSecurityGroupClient) DeleteEndpointSecurityGroup(tenant, appProfile, name string) error {
+	data := map[string]interface{}{
+		"fvESg": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(EndpointSecurityGroupDN(tenant, appProfile, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der EndpointSecurityGroup: %v", err)
+	}
+
+	log.Println("EndpointSecurityGroup erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveEndpointSecurityGroup liest eine Endpoint Security Group samt Selektoren und Relationen aus Cisco ACI.
+// Gibt nil zurück, wenn sie nicht existiert.
+func (c *EndpointSecurityGroupClient) ObserveEndpointSecurityGroup(tenant, appProfile, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(EndpointSecurityGroupDN(tenant, appProfile, name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der EndpointSecurityGroup: %w", err)
+	}
+	return mo, nil
+}
+
+// EndpointSecurityGroupUpToDate prüft, ob die beobachtete Endpoint Security Group der Spezifikation entspricht
+func EndpointSecurityGroupUpToDate(mo *ManagedObject, p v1alpha1.EndpointSecurityGroupParameters) bool {
+	if mo.Attributes["descr"] != p.Desc {
+		return false
+	}
+	if rs := mo.Child("fvRsScope"); rs == nil || rs.Attributes["tnFvCtxName"] != p.Vrf {
+		return false
+	}
+
+	expressions := make([]string, 0, len(p.IPSubnetSelectors))
+	for _, subnet := range p.IPSubnetSelectors {
+		expressions = append(expressions, ipSelectorExpression(subnet))
+	}
+	if !relationsMatch("fvEPgSelector", "matchEpgDn", p.EPGSelectors, mo.Children) ||
+		!relationsMatch("fvEPSelector", "matchExpression", expressions, mo.Children) {
+		return false
+	}
+
+	if len(mo.ChildrenOf("fvTagSelector")) != len(p.TagSelectors) {
+		return false
+	}
+	for _, tag := range p.TagSelectors {
+		found := false
+		for _, observed := range mo.ChildrenOf("fvTagSelector") {
+			if attributesMatch(observed.Attributes, tagSelectorAttributes(tag)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return contractRelationsMatch(p.Contracts, mo.Children)
+}
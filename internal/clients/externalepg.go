@@ -0,0 +1,181 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// externalSubnetScopes sind die zulässigen scope-Flags eines l3extSubnet
+var externalSubnetScopes = map[string]bool{
+	"import-security": true, "shared-security": true,
+	"import-rtctrl": true, "export-rtctrl": true, "shared-rtctrl": true,
+}
+
+// externalSubnetAggregates sind die zulässigen aggregate-Flags eines l3extSubnet
+var externalSubnetAggregates = map[string]bool{
+	"import-rtctrl": true, "export-rtctrl": true, "shared-rtctrl": true,
+}
+
+// ExternalEPGClient verwaltet Operationen für externe EPGs (l3extInstP) in Cisco ACI
+type ExternalEPGClient struct {
+	client *Client
+}
+
+// NewExternalEPGClient initialisiert einen neuen ExternalEPG-Client
+func NewExternalEPGClient(client *Client) *ExternalEPGClient {
+	return &ExternalEPGClient{
+		client: client,
+	}
+}
+
+// ExternalEPGDN liefert den DN einer externen EPG
+func ExternalEPGDN(tenant, l3Out, name string) string {
+	return fmt.Sprintf("%s/instP-%s", L3OutDN(tenant, l3Out), name)
+}
+
+// ValidateExternalEPG prüft die scope- und aggregate-Flags der Subnetze, bevor sie an die APIC gesendet werden
+func ValidateExternalEPG(p v1alpha1.ExternalEPGParameters) error {
+	for _, subnet := range p.Subnets {
+		for _, scope := range subnet.Scope {
+			if !externalSubnetScopes[scope] {
+				return fmt.Errorf("ungültiger Scope %q für Subnetz %s", scope, subnet.IP)
+			}
+		}
+		for _, aggregate := range subnet.Aggregate {
+			if !externalSubnetAggregates[aggregate] {
+				return fmt.Errorf("ungültiges Aggregate-Flag %q für Subnetz %s", aggregate, subnet.IP)
+			}
+		}
+	}
+	return nil
+}
+
+// externalSubnetAttributes liefert die Attribute eines l3extSubnet. Beide Flags werden immer gesendet,
+// damit entfernte Flags zurückgesetzt werden; ohne Scope gilt der Default der APIC (import-security).
+func externalSubnetAttributes(subnet v1alpha1.ExternalEPGSubnet) map[string]string {
+	scope := subnetScope(subnet.Scope)
+	if scope == "" {
+		scope = "import-security"
+	}
+	return map[string]string{
+		"ip":        subnet.IP,
+		"scope":     scope,
+		"aggregate": subnetScope(subnet.Aggregate),
+	}
+}
+
+// externalEPGPayload baut die l3extInstP-Payload inklusive Subnetzen und Contract-Relationen auf.
+// observed ist die aktuelle externe EPG oder nil; nicht mehr gewünschte Subnetze werden gelöscht.
+func externalEPGPayload(p v1alpha1.ExternalEPGParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, subnet := range p.Subnets {
+		wanted[subnet.IP] = true
+		attrs := externalSubnetAttributes(subnet)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"l3extSubnet": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	children = append(children, deletedChildren("l3extSubnet", "ip", wanted, observed.ChildrenOf("l3extSubnet"))...)
+
+	var observedChildren []ManagedObject
+	if observed != nil {
+		observedChildren = observed.Children
+	}
+	children = append(children, contractRelationChildren(p.Contracts, observedChildren)...)
+
+	return map[string]interface{}{
+		"l3extInstP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     ExternalEPGDN(p.Tenant, p.L3Out, p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateExternalEPG erstellt eine neue externe EPG in Cisco ACI
+func (c *ExternalEPGClient) CreateExternalEPG(p v1alpha1.ExternalEPGParameters) error {
+	if err := ValidateExternalEPG(p); err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(ExternalEPGDN(p.Tenant, p.L3Out, p.Name), externalEPGPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der ExternalEPG: %v", err)
+	}
+
+	log.Println("ExternalEPG erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateExternalEPG aktualisiert eine bestehende externe EPG in Cisco ACI
+func (c *ExternalEPGClient) UpdateExternalEPG(p v1alpha1.ExternalEPGParameters) error {
+	if err := ValidateExternalEPG(p); err != nil {
+		return err
+	}
+
+	observed, err := c.ObserveExternalEPG(p.Tenant, p.L3Out, p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(ExternalEPGDN(p.Tenant, p.L3Out, p.Name), externalEPGPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der ExternalEPG: %v", err)
+	}
+
+	log.Println("ExternalEPG erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteExternalEPG löscht eine bestehende externe EPG in Cisco ACI
+func (c *ExternalEPGClient) DeleteExternalEPG(tenant, l3Out, name string) error {
+	data := map[string]interface{}{
+		"l3extInstP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(ExternalEPGDN(tenant, l3Out, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der ExternalEPG: %v", err)
+	}
+
+	log.Println("ExternalEPG erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveExternalEPG liest eine externe EPG samt Subnetzen und Relationen aus Cisco ACI.
+// Gibt nil zurück, wenn sie nicht existiert.
+func (c *ExternalEPGClient) ObserveExternalEPG(tenant, l3Out, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(ExternalEPGDN(tenant, l3Out, name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der ExternalEPG: %w", err)
+	}
+	return mo, nil
+}
+
+// ExternalEPGUpToDate prüft, ob die beobachtete externe EPG der Spezifikation entspricht
+func ExternalEPGUpToDate(mo *ManagedObject, p v1alpha1.ExternalEPGParameters) bool {
+	if mo.Attributes["descr"] != p.Desc {
+		return false
+	}
+	if len(mo.ChildrenOf("l3extSubnet")) != len(p.Subnets) {
+		return false
+	}
+	for _, subnet := range p.Subnets {
+		observed := mo.ChildBy("l3extSubnet", "ip", subnet.IP)
+		if observed == nil || !flagsMatch(observed.Attributes, externalSubnetAttributes(subnet), "scope", "aggregate") {
+			return false
+		}
+	}
+	return contractRelationsMatch(p.Contracts, mo.Children)
+}
@@ -0,0 +1,107 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestExternalEPGUpToDate(t *testing.T) {
+	p := v1alpha1.ExternalEPGParameters{
+		Name:   "ext1",
+		Tenant: "tn1",
+		L3Out:  "out1",
+		Subnets: []v1alpha1.ExternalEPGSubnet{
+			{IP: "0.0.0.0/0", Scope: []string{"import-security", "export-rtctrl"}},
+		},
+	}
+
+	cases := map[string]struct {
+		scope string
+		want  bool
+	}{
+		"SortedScope":   {scope: "export-rtctrl,import-security", want: true},
+		"UnsortedScope": {scope: "import-security,export-rtctrl", want: true},
+		"MissingFlag":   {scope: "import-security", want: false},
+		"ExtraFlag":     {scope: "export-rtctrl,import-security,shared-security", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mo := &ManagedObject{
+				Class:      "l3extInstP",
+				Attributes: map[string]string{"name": "ext1"},
+				Children: []ManagedObject{
+					{Class: "l3extSubnet", Attributes: map[string]string{"ip": "0.0.0.0/0", "scope": tc.scope}},
+				},
+			}
+			if got := ExternalEPGUpToDate(mo, p); got != tc.want {
+				t.Errorf("ExternalEPGUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExternalEPGUpToDateClearedFlags(t *testing.T) {
+	cases := map[string]struct {
+		observed map[string]string
+		subnet   v1alpha1.ExternalEPGSubnet
+		want     bool
+	}{
+		"AggregateRemovedFromSpec": {
+			observed: map[string]string{"ip": "10.0.0.0/8", "scope": "export-rtctrl", "aggregate": "export-rtctrl"},
+			subnet:   v1alpha1.ExternalEPGSubnet{IP: "10.0.0.0/8", Scope: []string{"export-rtctrl"}},
+			want:     false,
+		},
+		"NoAggregate": {
+			observed: map[string]string{"ip": "10.0.0.0/8", "scope": "export-rtctrl", "aggregate": ""},
+			subnet:   v1alpha1.ExternalEPGSubnet{IP: "10.0.0.0/8", Scope: []string{"export-rtctrl"}},
+			want:     true,
+		},
+		"ScopeRemovedFromSpec": {
+			observed: map[string]string{"ip": "10.0.0.0/8", "scope": "import-security,shared-security", "aggregate": ""},
+			subnet:   v1alpha1.ExternalEPGSubnet{IP: "10.0.0.0/8"},
+			want:     false,
+		},
+		"DefaultScope": {
+			observed: map[string]string{"ip": "10.0.0.0/8", "scope": "import-security", "aggregate": ""},
+			subnet:   v1alpha1.ExternalEPGSubnet{IP: "10.0.0.0/8"},
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.ExternalEPGParameters{Name: "ext1", Tenant: "tn1", L3Out: "out1", Subnets: []v1alpha1.ExternalEPGSubnet{tc.subnet}}
+			mo := &ManagedObject{
+				Class:      "l3extInstP",
+				Attributes: map[string]string{"name": "ext1"},
+				Children:   []ManagedObject{{Class: "l3extSubnet", Attributes: tc.observed}},
+			}
+			if got := ExternalEPGUpToDate(mo, p); got != tc.want {
+				t.Errorf("ExternalEPGUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExternalEPGPayloadClearsAggregate(t *testing.T) {
+	p := v1alpha1.ExternalEPGParameters{
+		Name:    "ext1",
+		Tenant:  "tn1",
+		L3Out:   "out1",
+		Subnets: []v1alpha1.ExternalEPGSubnet{{IP: "10.0.0.0/8"}},
+	}
+	payload := externalEPGPayload(p, "modified", nil)
+	children := payload["l3extInstP"].(map[string]interface{})["children"].([]interface{})
+	subnet := children[0].(map[string]interface{})["l3extSubnet"].(map[string]interface{})
+	attrs := subnet["attributes"].(map[string]string)
+
+	aggregate, ok := attrs["aggregate"]
+	if !ok || aggregate != "" {
+		t.Errorf("aggregate = %q (sent: %v), want it sent as an empty string", aggregate, ok)
+	}
+	if attrs["scope"] != "import-security" {
+		t.Errorf("scope = %q, want import-security", attrs["scope"])
+	}
+}
@@ -0,0 +1,139 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// FabricNodeClient verwaltet Operationen für Node-Registrierungen (fabricNodeIdentP) in Cisco ACI
+type FabricNodeClient struct {
+	client *Client
+}
+
+// NewFabricNodeClient initialisiert einen neuen FabricNode-Client
+func NewFabricNodeClient(client *Client) *FabricNodeClient {
+	return &FabricNodeClient{
+		client: client,
+	}
+}
+
+// FabricNodeDN liefert den DN der Registrierung einer Seriennummer
+func FabricNodeDN(serial string) string {
+	return fmt.Sprintf("uni/controller/nodeidentpol/nodep-%s", serial)
+}
+
+// fabricNodePod liefert den Pod eines Nodes; ohne Angabe Pod 1
+func fabricNodePod(p v1alpha1.FabricNodeParameters) string {
+	if p.PodID == "" {
+		return "1"
+	}
+	return p.PodID
+}
+
+// fabricNodeAttributes liefert die konfigurierbaren fabricNodeIdentP-Attribute; leere optionale Werte werden weggelassen
+func fabricNodeAttributes(p v1alpha1.FabricNodeParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"serial": p.SerialNumber,
+		"nodeId": strconv.Itoa(p.NodeID),
+		"name":   p.Name,
+		"role":   p.Role,
+		"podId":  fabricNodePod(p),
+	}, map[string]string{
+		"nodeType": p.NodeType,
+	})
+}
+
+// fabricNodePayload baut die fabricNodeIdentP-Payload auf
+func fabricNodePayload(p v1alpha1.FabricNodeParameters, status string) map[string]interface{} {
+	attrs := fabricNodeAttributes(p)
+	attrs["dn"] = FabricNodeDN(p.SerialNumber)
+	attrs["status"] = status
+
+	return map[string]interface{}{
+		"fabricNodeIdentP": map[string]interface{}{
+			"attributes": attrs,
+		},
+	}
+}
+
+// CreateFabricNode registriert eine Seriennummer in Cisco ACI
+func (c *FabricNodeClient) CreateFabricNode(p v1alpha1.FabricNodeParameters) error {
+	if err := c.client.PostMO(FabricNodeDN(p.SerialNumber), fabricNodePayload(p, "created")); err != nil {
+		return fmt.Errorf("Fehler beim Registrieren des Nodes: %v", err)
+	}
+
+	log.Println("Node erfolgreich registriert!")
+	return nil
+}
+
+// UpdateFabricNode aktualisiert die Registrierung einer Seriennummer in Cisco ACI
+func (c *FabricNodeClient) UpdateFabricNode(p v1alpha1.FabricNodeParameters) error {
+	if err := c.client.PostMO(FabricNodeDN(p.SerialNumber), fabricNodePayload(p, "modified")); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der Node-Registrierung: %v", err)
+	}
+
+	log.Println("Node-Registrierung erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteFabricNode entfernt die Registrierung einer Seriennummer in Cisco ACI
+func (c *FabricNodeClient) DeleteFabricNode(serial string) error {
+	data := map[string]interface{}{
+		"fabricNodeIdentP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(FabricNodeDN(serial), data); err != nil {
+		return fmt.Errorf("Fehler beim Entfernen der Node-Registrierung: %v", err)
+	}
+
+	log.Println("Node-Registrierung erfolgreich entfernt!")
+	return nil
+}
+
+// ObserveFabricNode liest die Registrierung einer Seriennummer aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *FabricNodeClient) ObserveFabricNode(serial string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(FabricNodeDN(serial), "")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der Node-Registrierung: %w", err)
+	}
+	return mo, nil
+}
+
+// ObserveFabricNodeState liest den Zustand des Nodes aus fabricNode und topSystem. Solange der Node
+// noch nicht entdeckt wurde, existieren beide Objekte nicht und die Beobachtung bleibt leer.
+func (c *FabricNodeClient) ObserveFabricNodeState(p v1alpha1.FabricNodeParameters) (v1alpha1.FabricNodeObservation, error) {
+	obs := v1alpha1.FabricNodeObservation{}
+	nodeDN := fmt.Sprintf("topology/pod-%s/node-%d", fabricNodePod(p), p.NodeID)
+
+	node, err := c.client.GetMO(nodeDN, "")
+	if err != nil {
+		return obs, fmt.Errorf("Fehler beim Beobachten des Fabric-Nodes: %w", err)
+	}
+	if node != nil {
+		obs.FabricState = node.Attributes["fabricSt"]
+		obs.Model = node.Attributes["model"]
+		obs.Version = node.Attributes["version"]
+	}
+
+	sys, err := c.client.GetMO(nodeDN+"/sys", "")
+	if err != nil {
+		return obs, fmt.Errorf("Fehler beim Beobachten des Systems: %w", err)
+	}
+	if sys != nil {
+		obs.SystemState = sys.Attributes["state"]
+		obs.Address = sys.Attributes["address"]
+	}
+	return obs, nil
+}
+
+// FabricNodeUpToDate prüft, ob die beobachtete Registrierung der Spezifikation entspricht
+func FabricNodeUpToDate(mo *ManagedObject, p v1alpha1.FabricNodeParameters) bool {
+	return attributesMatch(mo.Attributes, fabricNodeAttributes(p))
+}
@@ -0,0 +1,98 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// FilterClient verwaltet Operationen für Filter (vzFilter) in Cisco ACI
+type FilterClient struct {
+	client *Client
+}
+
+// NewFilterClient initialisiert einen neuen Filter-Client
+func NewFilterClient(client *Client) *FilterClient {
+	return &FilterClient{
+		client: client,
+	}
+}
+
+// FilterDN liefert den DN eines Filters
+func FilterDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/flt-%s", tenant, name)
+}
+
+// filterAttributes liefert die konfigurierbaren vzFilter-Attribute
+func filterAttributes(p v1alpha1.FilterParameters) map[string]string {
+	return map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}
+}
+
+// filterPayload baut die vzFilter-Payload auf
+func filterPayload(p v1alpha1.FilterParameters, status string) map[string]interface{} {
+	attrs := filterAttributes(p)
+	attrs["dn"] = FilterDN(p.Tenant, p.Name)
+	attrs["status"] = status
+
+	return map[string]interface{}{
+		"vzFilter": map[string]interface{}{
+			"attributes": attrs,
+		},
+	}
+}
+
+// CreateFilter erstellt einen neuen Filter in Cisco ACI
+func (c *FilterClient) CreateFilter(p v1alpha1.FilterParameters) error {
+	if err := c.client.PostMO(FilterDN(p.Tenant, p.Name), filterPayload(p, "created")); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des Filters: %v", err)
+	}
+
+	log.Println("Filter erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateFilter aktualisiert einen bestehenden Filter in Cisco ACI
+func (c *FilterClient) UpdateFilter(p v1alpha1.FilterParameters) error {
+	if err := c.client.PostMO(FilterDN(p.Tenant, p.Name), filterPayload(p, "modified")); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des Filters: %v", err)
+	}
+
+	log.Println("Filter erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteFilter löscht einen bestehenden Filter in Cisco ACI
+func (c *FilterClient) DeleteFilter(tenant, name string) error {
+	data := map[string]interface{}{
+		"vzFilter": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(FilterDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des Filters: %v", err)
+	}
+
+	log.Println("Filter erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveFilter liest einen Filter aus Cisco ACI. Gibt nil zurück, wenn er nicht existiert.
+func (c *FilterClient) ObserveFilter(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(FilterDN(tenant, name), "")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des Filters: %w", err)
+	}
+	return mo, nil
+}
+
+// FilterUpToDate prüft, ob der beobachtete Filter der Spezifikation entspricht
+func FilterUpToDate(mo *ManagedObject, p v1alpha1.FilterParameters) bool {
+	return attributesMatch(mo.Attributes, filterAttributes(p))
+}
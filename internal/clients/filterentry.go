@@ -0,0 +1,242 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// namedPorts sind die Portnamen, die die APIC in vzEntry anstelle der Portnummer akzeptiert
+var namedPorts = map[string]int{
+	"unspecified": 0,
+	"ftpData":     20,
+	"smtp":        25,
+	"dns":         53,
+	"http":        80,
+	"pop3":        110,
+	"https":       443,
+	"rtsp":        554,
+}
+
+// namedProtocols sind die IP-Protokollnamen, die die APIC in vzEntry akzeptiert, mit ihrer Protokollnummer
+var namedProtocols = map[string]int{
+	"unspecified": 0, "icmp": 1, "igmp": 2, "tcp": 6, "egp": 8, "igp": 9,
+	"udp": 17, "icmpv6": 58, "eigrp": 88, "ospfigp": 89, "pim": 103, "l2tp": 115,
+}
+
+// tcpFlags sind die zulässigen Werte für tcpRules
+var tcpFlags = map[string]bool{
+	"unspecified": true, "est": true, "syn": true, "ack": true, "fin": true, "rst": true,
+}
+
+// FilterEntryClient verwaltet Operationen für Filter-Einträge (vzEntry) in Cisco ACI
+type FilterEntryClient struct {
+	client *Client
+}
+
+// NewFilterEntryClient initialisiert einen neuen FilterEntry-Client
+func NewFilterEntryClient(client *Client) *FilterEntryClient {
+	return &FilterEntryClient{
+		client: client,
+	}
+}
+
+// FilterEntryDN liefert den DN eines Filter-Eintrags
+func FilterEntryDN(tenant, filter, name string) string {
+	return fmt.Sprintf("%s/e-%s", FilterDN(tenant, filter), name)
+}
+
+// portNumber wandelt einen benannten oder numerischen Port in die Portnummer um
+func portNumber(port string) (int, bool) {
+	if n, ok := namedPorts[port]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 0 || n > 65535 {
+		return 0, false
+	}
+	return n, true
+}
+
+// protocolName wandelt die Nummer eines benannten Protokolls in den Namen um, den die APIC zurückliefert.
+// Andere Werte werden unverändert übernommen.
+func protocolName(prot string) string {
+	n, err := strconv.Atoi(prot)
+	if err != nil {
+		return prot
+	}
+	for name, number := range namedProtocols {
+		if number == n {
+			return name
+		}
+	}
+	return prot
+}
+
+// ValidateFilterEntry prüft Protokoll, Ports und TCP-Flags, bevor sie an die APIC gesendet werden
+func ValidateFilterEntry(p v1alpha1.FilterEntryParameters) error {
+	prot := protocolName(p.Prot)
+	if _, ok := namedProtocols[prot]; prot != "" && !ok {
+		n, err := strconv.Atoi(prot)
+		if err != nil || n < 0 || n > 255 {
+			return fmt.Errorf("ungültiges IP-Protokoll %q", p.Prot)
+		}
+	}
+
+	ranges := [][2]string{{p.SFromPort, p.SToPort}, {p.DFromPort, p.DToPort}}
+	hasPorts := false
+	for _, r := range ranges {
+		var bounds [2]int
+		for i, port := range r {
+			if port == "" {
+				continue
+			}
+			n, ok := portNumber(port)
+			if !ok {
+				return fmt.Errorf("ungültiger Port %q, erlaubt sind 0-65535 und die Namen ftpData, smtp, dns, http, pop3, https, rtsp", port)
+			}
+			bounds[i] = n
+			if n != 0 {
+				hasPorts = true
+			}
+		}
+		if r[0] != "" && r[1] != "" && bounds[0] > bounds[1] {
+			return fmt.Errorf("ungültiger Portbereich %s-%s", r[0], r[1])
+		}
+	}
+	if hasPorts && prot != "tcp" && prot != "udp" {
+		return fmt.Errorf("Ports sind nur mit den Protokollen tcp und udp zulässig")
+	}
+	if hasPorts && p.ApplyToFrag == "yes" {
+		return fmt.Errorf("applyToFrag kann nicht mit Ports kombiniert werden")
+	}
+
+	for _, flag := range p.TCPRules {
+		if !tcpFlags[flag] {
+			return fmt.Errorf("ungültiges TCP-Flag %q, erlaubt sind est, syn, ack, fin und rst", flag)
+		}
+	}
+	if len(p.TCPRules) > 0 && prot != "tcp" {
+		return fmt.Errorf("TCP-Flags sind nur mit dem Protokoll tcp zulässig")
+	}
+	return nil
+}
+
+// tcpRules liefert die TCP-Flags im Format der APIC (sortiert, kommagetrennt)
+func tcpRules(flags []string) string {
+	sorted := append([]string(nil), flags...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// filterEntryAttributes liefert die konfigurierbaren vzEntry-Attribute ohne Ports; leere optionale Werte werden weggelassen
+func filterEntryAttributes(p v1alpha1.FilterEntryParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"etherT":      p.EtherT,
+		"prot":        protocolName(p.Prot),
+		"tcpRules":    tcpRules(p.TCPRules),
+		"stateful":    p.Stateful,
+		"applyToFrag": p.ApplyToFrag,
+	})
+}
+
+// filterEntryPorts liefert die Port-Attribute des Eintrags; leere Werte werden weggelassen
+func filterEntryPorts(p v1alpha1.FilterEntryParameters) map[string]string {
+	return withAttributes(nil, map[string]string{
+		"sFromPort": p.SFromPort,
+		"sToPort":   p.SToPort,
+		"dFromPort": p.DFromPort,
+		"dToPort":   p.DToPort,
+	})
+}
+
+// filterEntryPayload baut die vzEntry-Payload auf
+func filterEntryPayload(p v1alpha1.FilterEntryParameters, status string) map[string]interface{} {
+	attrs := withAttributes(filterEntryAttributes(p), filterEntryPorts(p))
+	attrs["dn"] = FilterEntryDN(p.Tenant, p.Filter, p.Name)
+	attrs["status"] = status
+
+	return map[string]interface{}{
+		"vzEntry": map[string]interface{}{
+			"attributes": attrs,
+		},
+	}
+}
+
+// CreateFilterEntry erstellt einen neuen Filter-Eintrag in Cisco ACI
+func (c *FilterEntryClient) CreateFilterEntry(p v1alpha1.FilterEntryParameters) error {
+	if err := ValidateFilterEntry(p); err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(FilterEntryDN(p.Tenant, p.Filter, p.Name), filterEntryPayload(p, "created")); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des FilterEntrys: %v", err)
+	}
+
+	log.Println("FilterEntry erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateFilterEntry aktualisiert einen bestehenden Filter-Eintrag in Cisco ACI
+func (c *FilterEntryClient) UpdateFilterEntry(p v1alpha1.FilterEntryParameters) error {
+	if err := ValidateFilterEntry(p); err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(FilterEntryDN(p.Tenant, p.Filter, p.Name), filterEntryPayload(p, "modified")); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des FilterEntrys: %v", err)
+	}
+
+	log.Println("FilterEntry erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteFilterEntry löscht einen bestehenden Filter-Eintrag in Cisco ACI
+func (c *FilterEntryClient) DeleteFilterEntry(tenant, filter, name string) error {
+	data := map[string]interface{}{
+		"vzEntry": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(FilterEntryDN(tenant, filter, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des FilterEntrys: %v", err)
+	}
+
+	log.Println("FilterEntry erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveFilterEntry liest einen Filter-Eintrag aus Cisco ACI. Gibt nil zurück, wenn er nicht existiert.
+func (c *FilterEntryClient) ObserveFilterEntry(tenant, filter, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(FilterEntryDN(tenant, filter, name), "")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des FilterEntrys: %w", err)
+	}
+	return mo, nil
+}
+
+// FilterEntryUpToDate prüft, ob der beobachtete Filter-Eintrag der Spezifikation entspricht.
+// Ports werden numerisch verglichen, da die APIC bekannte Portnummern durch ihre Namen ersetzt.
+func FilterEntryUpToDate(mo *ManagedObject, p v1alpha1.FilterEntryParameters) bool {
+	if !flagsMatch(mo.Attributes, filterEntryAttributes(p), "tcpRules") {
+		return false
+	}
+	for key, port := range filterEntryPorts(p) {
+		want, _ := portNumber(port)
+		got, ok := portNumber(mo.Attributes[key])
+		if !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
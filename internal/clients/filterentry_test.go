@@ -0,0 +1,132 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestValidateFilterEntry(t *testing.T) {
+	cases := map[string]struct {
+		p       v1alpha1.FilterEntryParameters
+		wantErr bool
+	}{
+		"Empty": {
+			p: v1alpha1.FilterEntryParameters{Name: "e1"},
+		},
+		"NamedProtocolWithPorts": {
+			p: v1alpha1.FilterEntryParameters{Prot: "tcp", DFromPort: "http", DToPort: "443"},
+		},
+		"NumericTCPWithPorts": {
+			p: v1alpha1.FilterEntryParameters{Prot: "6", DFromPort: "80", DToPort: "80"},
+		},
+		"NumericUDPWithPorts": {
+			p: v1alpha1.FilterEntryParameters{Prot: "17", DFromPort: "dns", DToPort: "dns"},
+		},
+		"UnnamedProtocolNumber": {
+			p: v1alpha1.FilterEntryParameters{Prot: "47"},
+		},
+		"ProtocolOutOfRange": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "256"},
+			wantErr: true,
+		},
+		"UnknownProtocolName": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "sctp"},
+			wantErr: true,
+		},
+		"PortsWithICMP": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "icmp", DFromPort: "80", DToPort: "80"},
+			wantErr: true,
+		},
+		"UnspecifiedPortsWithICMP": {
+			p: v1alpha1.FilterEntryParameters{Prot: "icmp", DFromPort: "unspecified", DToPort: "unspecified"},
+		},
+		"PortOutOfRange": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "tcp", DFromPort: "70000"},
+			wantErr: true,
+		},
+		"UnknownPortName": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "tcp", DFromPort: "ssh"},
+			wantErr: true,
+		},
+		"ReversedPortRange": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "tcp", SFromPort: "https", SToPort: "http"},
+			wantErr: true,
+		},
+		"PortsWithApplyToFrag": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "udp", DFromPort: "53", ApplyToFrag: "yes"},
+			wantErr: true,
+		},
+		"TCPRules": {
+			p: v1alpha1.FilterEntryParameters{Prot: "tcp", TCPRules: []string{"syn", "ack"}},
+		},
+		"TCPRulesWithNumericTCP": {
+			p: v1alpha1.FilterEntryParameters{Prot: "6", TCPRules: []string{"est"}},
+		},
+		"UnknownTCPRule": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "tcp", TCPRules: []string{"psh"}},
+			wantErr: true,
+		},
+		"TCPRulesWithUDP": {
+			p:       v1alpha1.FilterEntryParameters{Prot: "udp", TCPRules: []string{"est"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateFilterEntry(tc.p)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateFilterEntry() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterEntryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed map[string]string
+		p        v1alpha1.FilterEntryParameters
+		want     bool
+	}{
+		"NumericProtocol": {
+			observed: map[string]string{"name": "e1", "prot": "tcp"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "6"},
+			want:     true,
+		},
+		"ProtocolDiffers": {
+			observed: map[string]string{"name": "e1", "prot": "udp"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "6"},
+			want:     false,
+		},
+		"NamedPort": {
+			observed: map[string]string{"name": "e1", "prot": "tcp", "dFromPort": "http", "dToPort": "http"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "tcp", DFromPort: "80", DToPort: "80"},
+			want:     true,
+		},
+		"PortDiffers": {
+			observed: map[string]string{"name": "e1", "prot": "tcp", "dFromPort": "https"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "tcp", DFromPort: "80"},
+			want:     false,
+		},
+		"TCPRulesInOtherOrder": {
+			observed: map[string]string{"name": "e1", "prot": "tcp", "tcpRules": "syn,ack"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "tcp", TCPRules: []string{"ack", "syn"}},
+			want:     true,
+		},
+		"TCPRulesDiffer": {
+			observed: map[string]string{"name": "e1", "prot": "tcp", "tcpRules": "est"},
+			p:        v1alpha1.FilterEntryParameters{Name: "e1", Prot: "tcp", TCPRules: []string{"ack", "syn"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mo := &ManagedObject{Class: "vzEntry", Attributes: tc.observed}
+			if got := FilterEntryUpToDate(mo, tc.p); got != tc.want {
+				t.Errorf("FilterEntryUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// L3OutClient verwaltet Operationen für L3Outs (l3extOut) samt Node- und Interface-Profilen in Cisco ACI
+type L3OutClient struct {
+	client *Client
+}
+
+// NewL3OutClient initialisiert einen neuen L3Out-Client
+func NewL3OutClient(client *Client) *L3OutClient {
+	return &L3OutClient{
+		client: client,
+	}
+}
+
+// L3OutDN liefert den DN eines L3Outs
+func L3OutDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/out-%s", tenant, name)
+}
+
+// l3OutPathAttributes liefert die Attribute eines l3extRsPathL3OutAtt
+func l3OutPathAttributes(path v1alpha1.L3OutPath) map[string]string {
+	return withAttributes(map[string]string{
+		"tDn":     path.Path,
+		"ifInstT": path.Type,
+		"addr":    path.Address,
+	}, map[string]string{
+		"encap": path.Encap,
+		"mtu":   path.Mtu,
+	})
+}
+
+// l3OutNodeAttributes liefert die Attribute eines l3extRsNodeL3OutAtt
+func l3OutNodeAttributes(node v1alpha1.L3OutNode) map[string]string {
+	return withAttributes(map[string]string{
+		"tDn":   node.Node,
+		"rtrId": node.RouterID,
+	}, map[string]string{
+		"rtrIdLoopBack": node.RouterIDLoopback,
+	})
+}
+
+// l3OutInterfaceProfilePayload baut die l3extLIfP-Payload auf; observed ist das aktuelle Profil oder nil
+func l3OutInterfaceProfilePayload(ifp v1alpha1.L3OutInterfaceProfile, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, path := range ifp.Paths {
+		wanted[path.Path] = true
+		attrs := l3OutPathAttributes(path)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"l3extRsPathL3OutAtt": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	children = append(children, deletedChildren("l3extRsPathL3OutAtt", "tDn", wanted, observed.ChildrenOf("l3extRsPathL3OutAtt"))...)
+
+	return map[string]interface{}{
+		"l3extLIfP": map[string]interface{}{
+			"attributes": map[string]string{
+				"name":   ifp.Name,
+				"status": "created,modified",
+			},
+			"children": children,
+		},
+	}
+}
+
+// l3OutNodeProfilePayload baut die l3extLNodeP-Payload auf; observed ist das aktuelle Profil oder nil
+func l3OutNodeProfilePayload(np v1alpha1.L3OutNodeProfile, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wantedNodes := map[string]bool{}
+	for _, node := range np.Nodes {
+		wantedNodes[node.Node] = true
+		attrs := l3OutNodeAttributes(node)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"l3extRsNodeL3OutAtt": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	children = append(children, deletedChildren("l3extRsNodeL3OutAtt", "tDn", wantedNodes, observed.ChildrenOf("l3extRsNodeL3OutAtt"))...)
+
+	wantedProfiles := map[string]bool{}
+	for _, ifp := range np.InterfaceProfiles {
+		wantedProfiles[ifp.Name] = true
+		children = append(children, l3OutInterfaceProfilePayload(ifp, observed.ChildBy("l3extLIfP", "name", ifp.Name)))
+	}
+	children = append(children, deletedChildren("l3extLIfP", "name", wantedProfiles, observed.ChildrenOf("l3extLIfP"))...)
+
+	return map[string]interface{}{
+		"l3extLNodeP": map[string]interface{}{
+			"attributes": map[string]string{
+				"name":   np.Name,
+				"status": "created,modified",
+			},
+			"children": children,
+		},
+	}
+}
+
+// l3OutPayload baut die l3extOut-Payload inklusive VRF-, Domänen-Relation und Node-Profilen auf.
+// observed ist der aktuelle L3Out oder nil; nicht mehr gewünschte Profile werden gelöscht.
+func l3OutPayload(p v1alpha1.L3OutParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{
+		map[string]interface{}{
+			"l3extRsEctx": map[string]interface{}{
+				"attributes": map[string]string{
+					"tnFvCtxName": p.Vrf,
+					"status":      "created,modified",
+				},
+			},
+		},
+	}
+	children = append(children, relationChildren("l3extRsL3DomAtt", "tDn", []string{p.L3Domain}, observed.ChildrenOf("l3extRsL3DomAtt"))...)
+
+	wanted := map[string]bool{}
+	for _, np := range p.NodeProfiles {
+		wanted[np.Name] = true
+		children = append(children, l3OutNodeProfilePayload(np, observed.ChildBy("l3extLNodeP", "name", np.Name)))
+	}
+	children = append(children, deletedChildren("l3extLNodeP", "name", wanted, observed.ChildrenOf("l3extLNodeP"))...)
+
+	return map[string]interface{}{
+		"l3extOut": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     L3OutDN(p.Tenant, p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateL3Out erstellt einen neuen L3Out in Cisco ACI
+func (c *L3OutClient) CreateL3Out(p v1alpha1.L3OutParameters) error {
+	if err := c.client.PostMO(L3OutDN(p.Tenant, p.Name), l3OutPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des L3Outs: %v", err)
+	}
+
+	log.Println("L3Out erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateL3Out aktualisiert einen bestehenden L3Out in Cisco ACI
+func (c *L3OutClient) UpdateL3Out(p v1alpha1.L3OutParameters) error {
+	observed, err := c.ObserveL3Out(p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(L3OutDN(p.Tenant, p.Name), l3OutPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des L3Outs: %v", err)
+	}
+
+	log.Println("L3Out erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteL3Out löscht einen bestehenden L3Out in Cisco ACI
+func (c *L3OutClient) DeleteL3Out(tenant, name string) error {
+	data := map[string]interface{}{
+		"l3extOut": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(L3OutDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des L3Outs: %v", err)
+	}
+
+	log.Println("L3Out erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveL3Out liest einen L3Out mit allen Profilen aus Cisco ACI. Gibt nil zurück, wenn er nicht existiert.
+func (c *L3OutClient) ObserveL3Out(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(L3OutDN(tenant, name), "rsp-subtree=full&rsp-prop-include=config-only")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des L3Outs: %w", err)
+	}
+	return mo, nil
+}
+
+// l3OutNodeProfileUpToDate prüft, ob ein beobachtetes Node-Profil der Spezifikation entspricht
+func l3OutNodeProfileUpToDate(mo *ManagedObject, np v1alpha1.L3OutNodeProfile) bool {
+	if mo == nil || len(mo.ChildrenOf("l3extRsNodeL3OutAtt")) != len(np.Nodes) || len(mo.ChildrenOf("l3extLIfP")) != len(np.InterfaceProfiles) {
+		return false
+	}
+	for _, node := range np.Nodes {
+		rs := mo.ChildBy("l3extRsNodeL3OutAtt", "tDn", node.Node)
+		if rs == nil || !attributesMatch(rs.Attributes, l3OutNodeAttributes(node)) {
+			return false
+		}
+	}
+	for _, ifp := range np.InterfaceProfiles {
+		observed := mo.ChildBy("l3extLIfP", "name", ifp.Name)
+		if observed == nil || len(observed.ChildrenOf("l3extRsPathL3OutAtt")) != len(ifp.Paths) {
+			return false
+		}
+		for _, path := range ifp.Paths {
+			rs := observed.ChildBy("l3extRsPathL3OutAtt", "tDn", path.Path)
+			if rs == nil || !attributesMatch(rs.Attributes, l3OutPathAttributes(path)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// L3OutUpToDate prüft, ob der beobachtete L3Out samt Profilen der Spezifikation entspricht
+func L3OutUpToDate(mo *ManagedObject, p v1alpha1.L3OutParameters) bool {
+	if mo.Attributes["descr"] != p.Desc {
+		return false
+	}
+	if rs := mo.Child("l3extRsEctx"); rs == nil || rs.Attributes["tnFvCtxName"] != p.Vrf {
+		return false
+	}
+	if !relationsMatch("l3extRsL3DomAtt", "tDn", []string{p.L3Domain}, mo.Children) {
+		return false
+	}
+	if len(mo.ChildrenOf("l3extLNodeP")) != len(p.NodeProfiles) {
+		return false
+	}
+	for _, np := range p.NodeProfiles {
+		if !l3OutNodeProfileUpToDate(mo.ChildBy("l3extLNodeP", "name", np.Name), np) {
+			return false
+		}
+	}
+	return true
+}
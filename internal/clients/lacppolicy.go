@@ -0,0 +1,85 @@
+package clients
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LACPPolicyClient verwaltet Operationen für Port-Channel-Policies (lacpLagPol) in Cisco ACI
+type LACPPolicyClient struct {
+	policy *policyClient[v1alpha1.LACPPolicyParameters]
+}
+
+// NewLACPPolicyClient initialisiert einen neuen LACPPolicy-Client
+func NewLACPPolicyClient(client *Client) *LACPPolicyClient {
+	return &LACPPolicyClient{
+		policy: &policyClient[v1alpha1.LACPPolicyParameters]{
+			client:     client,
+			class:      "lacpLagPol",
+			label:      "LACP-Policy",
+			dn:         LACPPolicyDN,
+			attributes: lacpPolicyAttributes,
+		},
+	}
+}
+
+// LACPPolicyDN liefert den DN einer LACP-Policy
+func LACPPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/lacplagp-%s", name)
+}
+
+// lacpControl liefert das ctrl-Attribut der Port-Channel-Policy als sortierte, kommagetrennte Liste
+func lacpControl(flags []string) string {
+	sorted := append([]string(nil), flags...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// lacpPolicyAttributes liefert die konfigurierbaren lacpLagPol-Attribute; leere optionale Werte werden weggelassen
+func lacpPolicyAttributes(p v1alpha1.LACPPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"mode":     p.Mode,
+		"ctrl":     lacpControl(p.Control),
+		"minLinks": p.MinLinks,
+		"maxLinks": p.MaxLinks,
+	})
+}
+
+// CreateLACPPolicy erstellt eine neue LACP-Policy in Cisco ACI
+func (c *LACPPolicyClient) CreateLACPPolicy(p v1alpha1.LACPPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateLACPPolicy aktualisiert eine bestehende LACP-Policy in Cisco ACI
+func (c *LACPPolicyClient) UpdateLACPPolicy(p v1alpha1.LACPPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteLACPPolicy löscht eine bestehende LACP-Policy in Cisco ACI
+func (c *LACPPolicyClient) DeleteLACPPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveLACPPolicy liest eine LACP-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *LACPPolicyClient) ObserveLACPPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// LACPPolicyUpToDate prüft, ob die beobachtete LACP-Policy der Spezifikation entspricht
+func LACPPolicyUpToDate(mo *ManagedObject, p v1alpha1.LACPPolicyParameters) bool {
+	desired := lacpPolicyAttributes(p)
+	// Der APIC liefert die ctrl-Flags nicht zwingend in sortierter Reihenfolge zurück
+	if ctrl, ok := desired["ctrl"]; ok {
+		if lacpControl(strings.Split(mo.Attributes["ctrl"], ",")) != ctrl {
+			return false
+		}
+		delete(desired, "ctrl")
+	}
+	return attributesMatch(mo.Attributes, desired)
+}
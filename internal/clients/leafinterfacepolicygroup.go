@@ -0,0 +1,262 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LeafInterfacePolicyGroupClient verwaltet Operationen für Leaf-Interface-Policy-Gruppen
+// (infraAccPortGrp und infraAccBndlGrp) in Cisco ACI
+type LeafInterfacePolicyGroupClient struct {
+	client *Client
+}
+
+// NewLeafInterfacePolicyGroupClient initialisiert einen neuen LeafInterfacePolicyGroup-Client
+func NewLeafInterfacePolicyGroupClient(client *Client) *LeafInterfacePolicyGroupClient {
+	return &LeafInterfacePolicyGroupClient{
+		client: client,
+	}
+}
+
+// leafInterfacePolicyRelation beschreibt die Relation einer Policy-Gruppe auf eine Interface-Policy
+type leafInterfacePolicyRelation struct {
+	class    string
+	nameAttr string
+	dn       func(name string) string
+	policy   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string
+	bundle   bool
+}
+
+// leafInterfacePolicyRelations sind die Relationen, die eine Policy-Gruppe auf Interface-Policies hat.
+// bundle markiert Relationen, die nur Port-Channel- und vPC-Gruppen besitzen.
+var leafInterfacePolicyRelations = []leafInterfacePolicyRelation{
+	{
+		class:    "infraRsHIfPol",
+		nameAttr: "tnFabricHIfPolName",
+		dn:       LinkLevelPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.LinkLevelPolicy },
+	},
+	{
+		class:    "infraRsCdpIfPol",
+		nameAttr: "tnCdpIfPolName",
+		dn:       CDPPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.CDPPolicy },
+	},
+	{
+		class:    "infraRsLldpIfPol",
+		nameAttr: "tnLldpIfPolName",
+		dn:       LLDPPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.LLDPPolicy },
+	},
+	{
+		class:    "infraRsLacpPol",
+		nameAttr: "tnLacpLagPolName",
+		dn:       LACPPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.LACPPolicy },
+		bundle:   true,
+	},
+	{
+		class:    "infraRsMcpIfPol",
+		nameAttr: "tnMcpIfPolName",
+		dn:       MCPPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.MCPPolicy },
+	},
+	{
+		class:    "infraRsStormctrlIfPol",
+		nameAttr: "tnStormctrlIfPolName",
+		dn:       StormControlPolicyDN,
+		policy:   func(p v1alpha1.LeafInterfacePolicyGroupParameters) string { return p.StormControlPolicy },
+	},
+}
+
+// leafInterfacePolicyGroupLagT liefert den Bündeltyp einer Policy-Gruppe; leer bei access
+func leafInterfacePolicyGroupLagT(typ string) string {
+	switch typ {
+	case "pc":
+		return "link"
+	case "vpc":
+		return "node"
+	}
+	return ""
+}
+
+// leafInterfacePolicyGroupClass liefert die Klasse einer Policy-Gruppe des angegebenen Typs
+func leafInterfacePolicyGroupClass(typ string) string {
+	if leafInterfacePolicyGroupLagT(typ) != "" {
+		return "infraAccBndlGrp"
+	}
+	return "infraAccPortGrp"
+}
+
+// LeafInterfacePolicyGroupDN liefert den DN einer Policy-Gruppe des angegebenen Typs
+func LeafInterfacePolicyGroupDN(name, typ string) string {
+	if leafInterfacePolicyGroupLagT(typ) != "" {
+		return fmt.Sprintf("uni/infra/funcprof/accbundle-%s", name)
+	}
+	return fmt.Sprintf("uni/infra/funcprof/accportgrp-%s", name)
+}
+
+// ValidateLeafInterfacePolicyGroup prüft eine Policy-Gruppe, bevor sie an den APIC geschickt wird
+func ValidateLeafInterfacePolicyGroup(p v1alpha1.LeafInterfacePolicyGroupParameters) error {
+	if p.Type == "access" && p.LACPPolicy != "" {
+		return fmt.Errorf("eine LACP-Policy ist nur für Port-Channel- und vPC-Policy-Gruppen erlaubt")
+	}
+	return nil
+}
+
+// MissingLeafInterfacePolicies liefert die DNs aller von der Policy-Gruppe referenzierten
+// Policies, die in Cisco ACI nicht existieren
+func (c *LeafInterfacePolicyGroupClient) MissingLeafInterfacePolicies(p v1alpha1.LeafInterfacePolicyGroupParameters) ([]string, error) {
+	dns := []string{}
+	for _, rel := range leafInterfacePolicyRelations {
+		if name := rel.policy(p); name != "" {
+			dns = append(dns, rel.dn(name))
+		}
+	}
+	if p.AttachableEntityProfile != "" {
+		dns = append(dns, p.AttachableEntityProfile)
+	}
+
+	missing := []string{}
+	for _, dn := range dns {
+		mo, err := c.client.GetMO(dn, "")
+		if err != nil {
+			return nil, fmt.Errorf("Fehler beim Prüfen der Policy %s: %w", dn, err)
+		}
+		if mo == nil {
+			missing = append(missing, dn)
+		}
+	}
+	return missing, nil
+}
+
+// leafInterfacePolicyGroupPayload baut die Payload der Policy-Gruppe inklusive aller Relationen auf.
+// observed ist die aktuelle Policy-Gruppe oder nil.
+func leafInterfacePolicyGroupPayload(p v1alpha1.LeafInterfacePolicyGroupParameters, status string, observed *ManagedObject) map[string]interface{} {
+	attrs := withAttributes(map[string]string{
+		"dn":     LeafInterfacePolicyGroupDN(p.Name, p.Type),
+		"name":   p.Name,
+		"descr":  p.Desc,
+		"status": status,
+	}, map[string]string{
+		"lagT": leafInterfacePolicyGroupLagT(p.Type),
+	})
+
+	// Ein leerer Policy-Name setzt die Relation auf die Default-Policy zurück
+	children := []interface{}{}
+	for _, rel := range leafInterfacePolicyRelations {
+		if rel.bundle && leafInterfacePolicyGroupLagT(p.Type) == "" {
+			continue
+		}
+		children = append(children, map[string]interface{}{
+			rel.class: map[string]interface{}{
+				"attributes": map[string]string{
+					rel.nameAttr: rel.policy(p),
+					"status":     "created,modified",
+				},
+			},
+		})
+	}
+
+	aaeps := []string{}
+	if p.AttachableEntityProfile != "" {
+		aaeps = append(aaeps, p.AttachableEntityProfile)
+	}
+	children = append(children, relationChildren("infraRsAttEntP", "tDn", aaeps, observed.ChildrenOf("infraRsAttEntP"))...)
+
+	return map[string]interface{}{
+		leafInterfacePolicyGroupClass(p.Type): map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateLeafInterfacePolicyGroup erstellt eine neue Policy-Gruppe in Cisco ACI
+func (c *LeafInterfacePolicyGroupClient) CreateLeafInterfacePolicyGroup(p v1alpha1.LeafInterfacePolicyGroupParameters) error {
+	if err := ValidateLeafInterfacePolicyGroup(p); err != nil {
+		return err
+	}
+	if err := c.client.PostMO(LeafInterfacePolicyGroupDN(p.Name, p.Type), leafInterfacePolicyGroupPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der Policy-Gruppe: %v", err)
+	}
+
+	log.Println("Policy-Gruppe erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateLeafInterfacePolicyGroup aktualisiert eine bestehende Policy-Gruppe in Cisco ACI
+func (c *LeafInterfacePolicyGroupClient) UpdateLeafInterfacePolicyGroup(p v1alpha1.LeafInterfacePolicyGroupParameters) error {
+	if err := ValidateLeafInterfacePolicyGroup(p); err != nil {
+		return err
+	}
+	observed, err := c.ObserveLeafInterfacePolicyGroup(p.Name, p.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(LeafInterfacePolicyGroupDN(p.Name, p.Type), leafInterfacePolicyGroupPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der Policy-Gruppe: %v", err)
+	}
+
+	log.Println("Policy-Gruppe erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteLeafInterfacePolicyGroup löscht eine bestehende Policy-Gruppe in Cisco ACI
+func (c *LeafInterfacePolicyGroupClient) DeleteLeafInterfacePolicyGroup(name, typ string) error {
+	data := map[string]interface{}{
+		leafInterfacePolicyGroupClass(typ): map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(LeafInterfacePolicyGroupDN(name, typ), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der Policy-Gruppe: %v", err)
+	}
+
+	log.Println("Policy-Gruppe erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveLeafInterfacePolicyGroup liest eine Policy-Gruppe samt Relationen aus Cisco ACI.
+// Gibt nil zurück, wenn sie nicht existiert.
+func (c *LeafInterfacePolicyGroupClient) ObserveLeafInterfacePolicyGroup(name, typ string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(LeafInterfacePolicyGroupDN(name, typ), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der Policy-Gruppe: %w", err)
+	}
+	return mo, nil
+}
+
+// LeafInterfacePolicyGroupUpToDate prüft, ob die beobachtete Policy-Gruppe der Spezifikation entspricht
+func LeafInterfacePolicyGroupUpToDate(mo *ManagedObject, p v1alpha1.LeafInterfacePolicyGroupParameters) bool {
+	if mo.Attributes["descr"] != p.Desc {
+		return false
+	}
+	if lagT := leafInterfacePolicyGroupLagT(p.Type); lagT != "" && mo.Attributes["lagT"] != lagT {
+		return false
+	}
+	for _, rel := range leafInterfacePolicyRelations {
+		if rel.bundle && leafInterfacePolicyGroupLagT(p.Type) == "" {
+			continue
+		}
+		current := ""
+		if rs := mo.Child(rel.class); rs != nil {
+			current = rs.Attributes[rel.nameAttr]
+		}
+		if current != rel.policy(p) {
+			return false
+		}
+	}
+
+	aaeps := []string{}
+	if p.AttachableEntityProfile != "" {
+		aaeps = append(aaeps, p.AttachableEntityProfile)
+	}
+	return relationsMatch("infraRsAttEntP", "tDn", aaeps, mo.Children)
+}
@@ -0,0 +1,281 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LeafInterfaceProfileClient verwaltet Operationen für Leaf-Interface-Profile (infraAccPortP) in Cisco ACI
+type LeafInterfaceProfileClient struct {
+	client *Client
+}
+
+// NewLeafInterfaceProfileClient initialisiert einen neuen LeafInterfaceProfile-Client
+func NewLeafInterfaceProfileClient(client *Client) *LeafInterfaceProfileClient {
+	return &LeafInterfaceProfileClient{
+		client: client,
+	}
+}
+
+// LeafInterfaceProfileDN liefert den DN eines Leaf-Interface-Profils
+func LeafInterfaceProfileDN(name string) string {
+	return fmt.Sprintf("uni/infra/accportprof-%s", name)
+}
+
+// portBlockIsBreakout prüft, ob ein Port-Bereich Breakout-Sub-Ports auswählt
+func portBlockIsBreakout(blk v1alpha1.PortBlock) bool {
+	return blk.FromSubPort != 0 || blk.ToSubPort != 0
+}
+
+// portBlockClass liefert die Klasse eines Port-Bereichs
+func portBlockClass(blk v1alpha1.PortBlock) string {
+	if portBlockIsBreakout(blk) {
+		return "infraSubPortBlk"
+	}
+	return "infraPortBlk"
+}
+
+// portBlockAttributes liefert die Attribute eines infraPortBlk bzw. infraSubPortBlk
+func portBlockAttributes(blk v1alpha1.PortBlock) map[string]string {
+	attrs := map[string]string{
+		"name":     blk.Name,
+		"fromCard": strconv.Itoa(blk.FromCard),
+		"toCard":   strconv.Itoa(blk.ToCard),
+		"fromPort": strconv.Itoa(blk.FromPort),
+		"toPort":   strconv.Itoa(blk.ToPort),
+	}
+	if portBlockIsBreakout(blk) {
+		attrs["fromSubPort"] = strconv.Itoa(blk.FromSubPort)
+		attrs["toSubPort"] = strconv.Itoa(blk.ToSubPort)
+	}
+	return attrs
+}
+
+// portPosition ist die Position eines (Sub-)Ports als Tupel aus Karte, Port und Sub-Port.
+// Ein Bereich von 1/10 bis 2/5 umfasst alle Positionen zwischen Anfang und Ende in dieser Reihenfolge.
+type portPosition [3]int
+
+// before prüft, ob die Position p vor q liegt
+func (p portPosition) before(q portPosition) bool {
+	for i := range p {
+		if p[i] != q[i] {
+			return p[i] < q[i]
+		}
+	}
+	return false
+}
+
+// portBlockBounds liefert Anfang und Ende eines Port-Bereichs. Ohne Sub-Ports umfasst ein Port
+// alle seine Sub-Ports.
+func portBlockBounds(blk v1alpha1.PortBlock) (portPosition, portPosition) {
+	if portBlockIsBreakout(blk) {
+		return portPosition{blk.FromCard, blk.FromPort, blk.FromSubPort}, portPosition{blk.ToCard, blk.ToPort, blk.ToSubPort}
+	}
+	return portPosition{blk.FromCard, blk.FromPort, 0}, portPosition{blk.ToCard, blk.ToPort, math.MaxInt}
+}
+
+// ValidateLeafInterfaceProfile prüft die Port-Bereiche eines Interface-Profils, bevor sie an den APIC geschickt werden
+func ValidateLeafInterfaceProfile(p v1alpha1.LeafInterfaceProfileParameters) error {
+	for _, sel := range p.Selectors {
+		for _, blk := range sel.PortBlocks {
+			if portBlockIsBreakout(blk) && (blk.FromSubPort == 0 || blk.ToSubPort == 0) {
+				return fmt.Errorf("ungültiger Sub-Port-Bereich %s/%s: %d-%d", sel.Name, blk.Name, blk.FromSubPort, blk.ToSubPort)
+			}
+			if from, to := portBlockBounds(blk); to.before(from) {
+				return fmt.Errorf("ungültiger Port-Bereich %s/%s: %d/%d-%d/%d", sel.Name, blk.Name, blk.FromCard, blk.FromPort, blk.ToCard, blk.ToPort)
+			}
+		}
+	}
+	return nil
+}
+
+// portBlocksOverlap prüft, ob sich zwei Port-Bereiche überschneiden. Ein ganzer Port überschneidet
+// sich mit jedem seiner Sub-Ports.
+func portBlocksOverlap(a, b v1alpha1.PortBlock) bool {
+	aFrom, aTo := portBlockBounds(a)
+	bFrom, bTo := portBlockBounds(b)
+	return !bTo.before(aFrom) && !aTo.before(bFrom)
+}
+
+// LeafInterfaceProfileOverlaps liefert eine Beschreibung aller Port-Bereiche, die sich überschneiden
+func LeafInterfaceProfileOverlaps(p v1alpha1.LeafInterfaceProfileParameters) []string {
+	type block struct {
+		selector string
+		blk      v1alpha1.PortBlock
+	}
+	blocks := []block{}
+	for _, sel := range p.Selectors {
+		for _, blk := range sel.PortBlocks {
+			blocks = append(blocks, block{selector: sel.Name, blk: blk})
+		}
+	}
+
+	overlaps := []string{}
+	for i := range blocks {
+		for j := i + 1; j < len(blocks); j++ {
+			a, b := blocks[i], blocks[j]
+			if portBlocksOverlap(a.blk, b.blk) {
+				overlaps = append(overlaps, fmt.Sprintf("%s/%s overlaps %s/%s", a.selector, a.blk.Name, b.selector, b.blk.Name))
+			}
+		}
+	}
+	return overlaps
+}
+
+// interfaceSelectorPayload baut die infraHPortS-Payload auf; observed ist der aktuelle Selektor oder nil
+func interfaceSelectorPayload(sel v1alpha1.InterfaceSelector, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]map[string]bool{
+		"infraPortBlk":    {},
+		"infraSubPortBlk": {},
+	}
+	for _, blk := range sel.PortBlocks {
+		class := portBlockClass(blk)
+		wanted[class][blk.Name] = true
+		attrs := portBlockAttributes(blk)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			class: map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	for class, names := range wanted {
+		children = append(children, deletedChildren(class, "name", names, observed.ChildrenOf(class))...)
+	}
+
+	groups := []string{}
+	if sel.PolicyGroup != "" {
+		groups = append(groups, sel.PolicyGroup)
+	}
+	children = append(children, relationChildren("infraRsAccBaseGrp", "tDn", groups, observed.ChildrenOf("infraRsAccBaseGrp"))...)
+
+	return map[string]interface{}{
+		"infraHPortS": map[string]interface{}{
+			"attributes": map[string]string{
+				"name":   sel.Name,
+				"descr":  sel.Desc,
+				"type":   "range",
+				"status": "created,modified",
+			},
+			"children": children,
+		},
+	}
+}
+
+// leafInterfaceProfilePayload baut die infraAccPortP-Payload auf. observed ist das aktuelle Profil oder nil;
+// nicht mehr gewünschte Selektoren und Port-Bereiche werden gelöscht.
+func leafInterfaceProfilePayload(p v1alpha1.LeafInterfaceProfileParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, sel := range p.Selectors {
+		wanted[sel.Name] = true
+		children = append(children, interfaceSelectorPayload(sel, observed.ChildBy("infraHPortS", "name", sel.Name)))
+	}
+	children = append(children, deletedChildren("infraHPortS", "name", wanted, observed.ChildrenOf("infraHPortS"), "type")...)
+
+	return map[string]interface{}{
+		"infraAccPortP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     LeafInterfaceProfileDN(p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateLeafInterfaceProfile erstellt ein neues Leaf-Interface-Profil in Cisco ACI
+func (c *LeafInterfaceProfileClient) CreateLeafInterfaceProfile(p v1alpha1.LeafInterfaceProfileParameters) error {
+	if err := ValidateLeafInterfaceProfile(p); err != nil {
+		return err
+	}
+	if err := c.client.PostMO(LeafInterfaceProfileDN(p.Name), leafInterfaceProfilePayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des Interface-Profils: %v", err)
+	}
+
+	log.Println("Interface-Profil erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateLeafInterfaceProfile aktualisiert ein bestehendes Leaf-Interface-Profil in Cisco ACI
+func (c *LeafInterfaceProfileClient) UpdateLeafInterfaceProfile(p v1alpha1.LeafInterfaceProfileParameters) error {
+	if err := ValidateLeafInterfaceProfile(p); err != nil {
+		return err
+	}
+	observed, err := c.ObserveLeafInterfaceProfile(p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(LeafInterfaceProfileDN(p.Name), leafInterfaceProfilePayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des Interface-Profils: %v", err)
+	}
+
+	log.Println("Interface-Profil erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteLeafInterfaceProfile löscht ein bestehendes Leaf-Interface-Profil in Cisco ACI
+func (c *LeafInterfaceProfileClient) DeleteLeafInterfaceProfile(name string) error {
+	data := map[string]interface{}{
+		"infraAccPortP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(LeafInterfaceProfileDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des Interface-Profils: %v", err)
+	}
+
+	log.Println("Interface-Profil erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveLeafInterfaceProfile liest ein Leaf-Interface-Profil samt Selektoren aus Cisco ACI.
+// Gibt nil zurück, wenn es nicht existiert.
+func (c *LeafInterfaceProfileClient) ObserveLeafInterfaceProfile(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(LeafInterfaceProfileDN(name), "rsp-subtree=full&rsp-prop-include=config-only")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des Interface-Profils: %w", err)
+	}
+	return mo, nil
+}
+
+// LeafInterfaceProfileUpToDate prüft, ob das beobachtete Leaf-Interface-Profil der Spezifikation entspricht
+func LeafInterfaceProfileUpToDate(mo *ManagedObject, p v1alpha1.LeafInterfaceProfileParameters) bool {
+	if mo.Attributes["descr"] != p.Desc || len(mo.ChildrenOf("infraHPortS")) != len(p.Selectors) {
+		return false
+	}
+	for _, sel := range p.Selectors {
+		observed := mo.ChildBy("infraHPortS", "name", sel.Name)
+		if observed == nil || observed.Attributes["descr"] != sel.Desc {
+			return false
+		}
+		groups := []string{}
+		if sel.PolicyGroup != "" {
+			groups = append(groups, sel.PolicyGroup)
+		}
+		if !relationsMatch("infraRsAccBaseGrp", "tDn", groups, observed.Children) {
+			return false
+		}
+		if len(observed.ChildrenOf("infraPortBlk"))+len(observed.ChildrenOf("infraSubPortBlk")) != len(sel.PortBlocks) {
+			return false
+		}
+		for _, blk := range sel.PortBlocks {
+			ob := observed.ChildBy(portBlockClass(blk), "name", blk.Name)
+			if ob == nil || !attributesMatch(ob.Attributes, portBlockAttributes(blk)) {
+				return false
+			}
+		}
+	}
+	return true
+}
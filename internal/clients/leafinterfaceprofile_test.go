@@ -0,0 +1,157 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func interfaceProfile(blocks ...v1alpha1.PortBlock) v1alpha1.LeafInterfaceProfileParameters {
+	sel := v1alpha1.InterfaceSelector{Name: "sel"}
+	sel.PortBlocks = append(sel.PortBlocks, blocks...)
+	return v1alpha1.LeafInterfaceProfileParameters{
+		Name:      "leaf101",
+		Selectors: []v1alpha1.InterfaceSelector{sel},
+	}
+}
+
+func TestValidateLeafInterfaceProfile(t *testing.T) {
+	cases := map[string]struct {
+		blk     v1alpha1.PortBlock
+		wantErr bool
+	}{
+		"SinglePort": {
+			blk: v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 10, ToPort: 10},
+		},
+		"AcrossCards": {
+			blk: v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 2, FromPort: 10, ToPort: 5},
+		},
+		"ReversedPorts": {
+			blk:     v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 10, ToPort: 5},
+			wantErr: true,
+		},
+		"ReversedCards": {
+			blk:     v1alpha1.PortBlock{Name: "b", FromCard: 2, ToCard: 1, FromPort: 1, ToPort: 48},
+			wantErr: true,
+		},
+		"SubPorts": {
+			blk: v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, FromSubPort: 1, ToSubPort: 4},
+		},
+		"SubPortsAcrossPorts": {
+			blk: v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 50, FromSubPort: 3, ToSubPort: 2},
+		},
+		"ReversedSubPorts": {
+			blk:     v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, FromSubPort: 4, ToSubPort: 1},
+			wantErr: true,
+		},
+		"MissingSubPort": {
+			blk:     v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, ToSubPort: 4},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateLeafInterfaceProfile(interfaceProfile(tc.blk))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateLeafInterfaceProfile() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestLeafInterfaceProfileOverlaps(t *testing.T) {
+	cases := map[string]struct {
+		a, b v1alpha1.PortBlock
+		want bool
+	}{
+		"DisjointPorts": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 1, ToPort: 10},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 11, ToPort: 20},
+			want: false,
+		},
+		"SharedPort": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 1, ToPort: 10},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 10, ToPort: 20},
+			want: true,
+		},
+		"OtherCard": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 1, ToPort: 10},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 2, ToCard: 2, FromPort: 1, ToPort: 10},
+			want: false,
+		},
+		"AcrossCardsContainsPort": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 2, FromPort: 10, ToPort: 5},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 20, ToPort: 20},
+			want: true,
+		},
+		"AcrossCardsBeforeStart": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 2, FromPort: 10, ToPort: 5},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 1, ToPort: 9},
+			want: false,
+		},
+		"AcrossCardsAfterEnd": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 2, FromPort: 10, ToPort: 5},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 2, ToCard: 2, FromPort: 6, ToPort: 48},
+			want: false,
+		},
+		"PortAndItsSubPorts": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, FromSubPort: 1, ToSubPort: 2},
+			want: true,
+		},
+		"DisjointSubPorts": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, FromSubPort: 1, ToSubPort: 2},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 49, FromSubPort: 3, ToSubPort: 4},
+			want: false,
+		},
+		"SubPortsAcrossPorts": {
+			a:    v1alpha1.PortBlock{Name: "a", FromCard: 1, ToCard: 1, FromPort: 49, ToPort: 50, FromSubPort: 3, ToSubPort: 1},
+			b:    v1alpha1.PortBlock{Name: "b", FromCard: 1, ToCard: 1, FromPort: 50, ToPort: 50, FromSubPort: 2, ToSubPort: 4},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := len(LeafInterfaceProfileOverlaps(interfaceProfile(tc.a, tc.b))) > 0
+			if got != tc.want {
+				t.Errorf("LeafInterfaceProfileOverlaps() reported overlap = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLeafSwitchProfileOverlaps(t *testing.T) {
+	cases := map[string]struct {
+		a, b v1alpha1.NodeBlock
+		want bool
+	}{
+		"Disjoint": {
+			a:    v1alpha1.NodeBlock{Name: "a", From: 101, To: 102},
+			b:    v1alpha1.NodeBlock{Name: "b", From: 103, To: 104},
+			want: false,
+		},
+		"SharedNode": {
+			a:    v1alpha1.NodeBlock{Name: "a", From: 101, To: 102},
+			b:    v1alpha1.NodeBlock{Name: "b", From: 102, To: 104},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.LeafSwitchProfileParameters{
+				Name: "leafs",
+				LeafSelectors: []v1alpha1.LeafSelector{
+					{Name: "s1", NodeBlocks: []v1alpha1.NodeBlock{tc.a}},
+					{Name: "s2", NodeBlocks: []v1alpha1.NodeBlock{tc.b}},
+				},
+			}
+			got := len(LeafSwitchProfileOverlaps(p)) > 0
+			if got != tc.want {
+				t.Errorf("LeafSwitchProfileOverlaps() reported overlap = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
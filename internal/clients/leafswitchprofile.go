@@ -0,0 +1,213 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LeafSwitchProfileClient verwaltet Operationen für Leaf-Switch-Profile (infraNodeP) in Cisco ACI
+type LeafSwitchProfileClient struct {
+	client *Client
+}
+
+// NewLeafSwitchProfileClient initialisiert einen neuen LeafSwitchProfile-Client
+func NewLeafSwitchProfileClient(client *Client) *LeafSwitchProfileClient {
+	return &LeafSwitchProfileClient{
+		client: client,
+	}
+}
+
+// LeafSwitchProfileDN liefert den DN eines Leaf-Switch-Profils
+func LeafSwitchProfileDN(name string) string {
+	return fmt.Sprintf("uni/infra/nprof-%s", name)
+}
+
+// nodeBlockAttributes liefert die Attribute eines infraNodeBlk
+func nodeBlockAttributes(blk v1alpha1.NodeBlock) map[string]string {
+	return map[string]string{
+		"name":  blk.Name,
+		"from_": strconv.Itoa(blk.From),
+		"to_":   strconv.Itoa(blk.To),
+	}
+}
+
+// ValidateLeafSwitchProfile prüft die Node-Bereiche eines Switch-Profils, bevor sie an den APIC geschickt werden
+func ValidateLeafSwitchProfile(p v1alpha1.LeafSwitchProfileParameters) error {
+	for _, sel := range p.LeafSelectors {
+		for _, blk := range sel.NodeBlocks {
+			if blk.From > blk.To {
+				return fmt.Errorf("ungültiger Node-Bereich %s/%s: %d-%d", sel.Name, blk.Name, blk.From, blk.To)
+			}
+		}
+	}
+	return nil
+}
+
+// LeafSwitchProfileOverlaps liefert eine Beschreibung aller Node-Bereiche, die sich überschneiden
+func LeafSwitchProfileOverlaps(p v1alpha1.LeafSwitchProfileParameters) []string {
+	type block struct {
+		selector string
+		blk      v1alpha1.NodeBlock
+	}
+	blocks := []block{}
+	for _, sel := range p.LeafSelectors {
+		for _, blk := range sel.NodeBlocks {
+			blocks = append(blocks, block{selector: sel.Name, blk: blk})
+		}
+	}
+
+	overlaps := []string{}
+	for i := range blocks {
+		for j := i + 1; j < len(blocks); j++ {
+			a, b := blocks[i], blocks[j]
+			if rangesOverlap(a.blk.From, a.blk.To, b.blk.From, b.blk.To) {
+				overlaps = append(overlaps, fmt.Sprintf("%s/%s (%d-%d) overlaps %s/%s (%d-%d)",
+					a.selector, a.blk.Name, a.blk.From, a.blk.To, b.selector, b.blk.Name, b.blk.From, b.blk.To))
+			}
+		}
+	}
+	return overlaps
+}
+
+// rangesOverlap prüft, ob sich die Bereiche [aFrom, aTo] und [bFrom, bTo] überschneiden
+func rangesOverlap(aFrom, aTo, bFrom, bTo int) bool {
+	return aFrom <= bTo && bFrom <= aTo
+}
+
+// leafSelectorPayload baut die infraLeafS-Payload auf; observed ist der aktuelle Selektor oder nil
+func leafSelectorPayload(sel v1alpha1.LeafSelector, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, blk := range sel.NodeBlocks {
+		wanted[blk.Name] = true
+		attrs := nodeBlockAttributes(blk)
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"infraNodeBlk": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	children = append(children, deletedChildren("infraNodeBlk", "name", wanted, observed.ChildrenOf("infraNodeBlk"))...)
+
+	return map[string]interface{}{
+		"infraLeafS": map[string]interface{}{
+			"attributes": map[string]string{
+				"name":   sel.Name,
+				"type":   "range",
+				"status": "created,modified",
+			},
+			"children": children,
+		},
+	}
+}
+
+// leafSwitchProfilePayload baut die infraNodeP-Payload auf. observed ist das aktuelle Profil oder nil;
+// nicht mehr gewünschte Selektoren und Node-Bereiche werden gelöscht.
+func leafSwitchProfilePayload(p v1alpha1.LeafSwitchProfileParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, sel := range p.LeafSelectors {
+		wanted[sel.Name] = true
+		children = append(children, leafSelectorPayload(sel, observed.ChildBy("infraLeafS", "name", sel.Name)))
+	}
+	children = append(children, deletedChildren("infraLeafS", "name", wanted, observed.ChildrenOf("infraLeafS"), "type")...)
+	children = append(children, relationChildren("infraRsAccPortP", "tDn", p.InterfaceProfiles, observed.ChildrenOf("infraRsAccPortP"))...)
+
+	return map[string]interface{}{
+		"infraNodeP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     LeafSwitchProfileDN(p.Name),
+				"name":   p.Name,
+				"descr":  p.Desc,
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateLeafSwitchProfile erstellt ein neues Leaf-Switch-Profil in Cisco ACI
+func (c *LeafSwitchProfileClient) CreateLeafSwitchProfile(p v1alpha1.LeafSwitchProfileParameters) error {
+	if err := ValidateLeafSwitchProfile(p); err != nil {
+		return err
+	}
+	if err := c.client.PostMO(LeafSwitchProfileDN(p.Name), leafSwitchProfilePayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des Switch-Profils: %v", err)
+	}
+
+	log.Println("Switch-Profil erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateLeafSwitchProfile aktualisiert ein bestehendes Leaf-Switch-Profil in Cisco ACI
+func (c *LeafSwitchProfileClient) UpdateLeafSwitchProfile(p v1alpha1.LeafSwitchProfileParameters) error {
+	if err := ValidateLeafSwitchProfile(p); err != nil {
+		return err
+	}
+	observed, err := c.ObserveLeafSwitchProfile(p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(LeafSwitchProfileDN(p.Name), leafSwitchProfilePayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des Switch-Profils: %v", err)
+	}
+
+	log.Println("Switch-Profil erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteLeafSwitchProfile löscht ein bestehendes Leaf-Switch-Profil in Cisco ACI
+func (c *LeafSwitchProfileClient) DeleteLeafSwitchProfile(name string) error {
+	data := map[string]interface{}{
+		"infraNodeP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(LeafSwitchProfileDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des Switch-Profils: %v", err)
+	}
+
+	log.Println("Switch-Profil erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveLeafSwitchProfile liest ein Leaf-Switch-Profil samt Selektoren aus Cisco ACI.
+// Gibt nil zurück, wenn es nicht existiert.
+func (c *LeafSwitchProfileClient) ObserveLeafSwitchProfile(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(LeafSwitchProfileDN(name), "rsp-subtree=full&rsp-prop-include=config-only")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des Switch-Profils: %w", err)
+	}
+	return mo, nil
+}
+
+// LeafSwitchProfileUpToDate prüft, ob das beobachtete Leaf-Switch-Profil der Spezifikation entspricht
+func LeafSwitchProfileUpToDate(mo *ManagedObject, p v1alpha1.LeafSwitchProfileParameters) bool {
+	if mo.Attributes["descr"] != p.Desc || !relationsMatch("infraRsAccPortP", "tDn", p.InterfaceProfiles, mo.Children) {
+		return false
+	}
+	if len(mo.ChildrenOf("infraLeafS")) != len(p.LeafSelectors) {
+		return false
+	}
+	for _, sel := range p.LeafSelectors {
+		observed := mo.ChildBy("infraLeafS", "name", sel.Name)
+		if observed == nil || len(observed.ChildrenOf("infraNodeBlk")) != len(sel.NodeBlocks) {
+			return false
+		}
+		for _, blk := range sel.NodeBlocks {
+			ob := observed.ChildBy("infraNodeBlk", "name", blk.Name)
+			if ob == nil || !attributesMatch(ob.Attributes, nodeBlockAttributes(blk)) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package clients
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LinkLevelPolicyClient verwaltet Operationen für Link-Level-Policies (fabricHIfPol) in Cisco ACI
+type LinkLevelPolicyClient struct {
+	policy *policyClient[v1alpha1.LinkLevelPolicyParameters]
+}
+
+// NewLinkLevelPolicyClient initialisiert einen neuen LinkLevelPolicy-Client
+func NewLinkLevelPolicyClient(client *Client) *LinkLevelPolicyClient {
+	return &LinkLevelPolicyClient{
+		policy: &policyClient[v1alpha1.LinkLevelPolicyParameters]{
+			client:     client,
+			class:      "fabricHIfPol",
+			label:      "Link-Level-Policy",
+			dn:         LinkLevelPolicyDN,
+			attributes: linkLevelPolicyAttributes,
+		},
+	}
+}
+
+// LinkLevelPolicyDN liefert den DN einer Link-Level-Policy
+func LinkLevelPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/hintfpol-%s", name)
+}
+
+// linkLevelPolicyAttributes liefert die konfigurierbaren fabricHIfPol-Attribute; leere optionale Werte werden weggelassen
+func linkLevelPolicyAttributes(p v1alpha1.LinkLevelPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"autoNeg":      p.AutoNeg,
+		"speed":        p.Speed,
+		"fecMode":      p.FECMode,
+		"linkDebounce": p.LinkDebounce,
+	})
+}
+
+// CreateLinkLevelPolicy erstellt eine neue Link-Level-Policy in Cisco ACI
+func (c *LinkLevelPolicyClient) CreateLinkLevelPolicy(p v1alpha1.LinkLevelPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateLinkLevelPolicy aktualisiert eine bestehende Link-Level-Policy in Cisco ACI
+func (c *LinkLevelPolicyClient) UpdateLinkLevelPolicy(p v1alpha1.LinkLevelPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteLinkLevelPolicy löscht eine bestehende Link-Level-Policy in Cisco ACI
+func (c *LinkLevelPolicyClient) DeleteLinkLevelPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveLinkLevelPolicy liest eine Link-Level-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *LinkLevelPolicyClient) ObserveLinkLevelPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// LinkLevelPolicyUpToDate prüft, ob die beobachtete Link-Level-Policy der Spezifikation entspricht
+func LinkLevelPolicyUpToDate(mo *ManagedObject, p v1alpha1.LinkLevelPolicyParameters) bool {
+	return attributesMatch(mo.Attributes, linkLevelPolicyAttributes(p))
+}
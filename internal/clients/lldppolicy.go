@@ -0,0 +1,66 @@
+package clients
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// LLDPPolicyClient verwaltet Operationen für LLDP-Interface-Policies (lldpIfPol) in Cisco ACI
+type LLDPPolicyClient struct {
+	policy *policyClient[v1alpha1.LLDPPolicyParameters]
+}
+
+// NewLLDPPolicyClient initialisiert einen neuen LLDPPolicy-Client
+func NewLLDPPolicyClient(client *Client) *LLDPPolicyClient {
+	return &LLDPPolicyClient{
+		policy: &policyClient[v1alpha1.LLDPPolicyParameters]{
+			client:     client,
+			class:      "lldpIfPol",
+			label:      "LLDP-Policy",
+			dn:         LLDPPolicyDN,
+			attributes: lldpPolicyAttributes,
+		},
+	}
+}
+
+// LLDPPolicyDN liefert den DN einer LLDP-Policy
+func LLDPPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/lldpIfP-%s", name)
+}
+
+// lldpPolicyAttributes liefert die konfigurierbaren lldpIfPol-Attribute; leere optionale Werte werden weggelassen
+func lldpPolicyAttributes(p v1alpha1.LLDPPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"adminRxSt": p.ReceiveState,
+		"adminTxSt": p.TransmitState,
+	})
+}
+
+// CreateLLDPPolicy erstellt eine neue LLDP-Policy in Cisco ACI
+func (c *LLDPPolicyClient) CreateLLDPPolicy(p v1alpha1.LLDPPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateLLDPPolicy aktualisiert eine bestehende LLDP-Policy in Cisco ACI
+func (c *LLDPPolicyClient) UpdateLLDPPolicy(p v1alpha1.LLDPPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteLLDPPolicy löscht eine bestehende LLDP-Policy in Cisco ACI
+func (c *LLDPPolicyClient) DeleteLLDPPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveLLDPPolicy liest eine LLDP-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *LLDPPolicyClient) ObserveLLDPPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// LLDPPolicyUpToDate prüft, ob die beobachtete LLDP-Policy der Spezifikation entspricht
+func LLDPPolicyUpToDate(mo *ManagedObject, p v1alpha1.LLDPPolicyParameters) bool {
+	return attributesMatch(mo.Attributes, lldpPolicyAttributes(p))
+}
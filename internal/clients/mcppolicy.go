@@ -0,0 +1,65 @@
+package clients
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// MCPPolicyClient verwaltet Operationen für MCP-Interface-Policies (mcpIfPol) in Cisco ACI
+type MCPPolicyClient struct {
+	policy *policyClient[v1alpha1.MCPPolicyParameters]
+}
+
+// NewMCPPolicyClient initialisiert einen neuen MCPPolicy-Client
+func NewMCPPolicyClient(client *Client) *MCPPolicyClient {
+	return &MCPPolicyClient{
+		policy: &policyClient[v1alpha1.MCPPolicyParameters]{
+			client:     client,
+			class:      "mcpIfPol",
+			label:      "MCP-Policy",
+			dn:         MCPPolicyDN,
+			attributes: mcpPolicyAttributes,
+		},
+	}
+}
+
+// MCPPolicyDN liefert den DN einer MCP-Policy
+func MCPPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/mcpIfP-%s", name)
+}
+
+// mcpPolicyAttributes liefert die konfigurierbaren mcpIfPol-Attribute; leere optionale Werte werden weggelassen
+func mcpPolicyAttributes(p v1alpha1.MCPPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"adminSt": p.AdminState,
+	})
+}
+
+// CreateMCPPolicy erstellt eine neue MCP-Policy in Cisco ACI
+func (c *MCPPolicyClient) CreateMCPPolicy(p v1alpha1.MCPPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateMCPPolicy aktualisiert eine bestehende MCP-Policy in Cisco ACI
+func (c *MCPPolicyClient) UpdateMCPPolicy(p v1alpha1.MCPPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteMCPPolicy löscht eine bestehende MCP-Policy in Cisco ACI
+func (c *MCPPolicyClient) DeleteMCPPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveMCPPolicy liest eine MCP-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *MCPPolicyClient) ObserveMCPPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// MCPPolicyUpToDate prüft, ob die beobachtete MCP-Policy der Spezifikation entspricht
+func MCPPolicyUpToDate(mo *ManagedObject, p v1alpha1.MCPPolicyParameters) bool {
+	return attributesMatch(mo.Attributes, mcpPolicyAttributes(p))
+}
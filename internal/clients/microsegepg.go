@@ -0,0 +1,205 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// MicroSegEPGClient verwaltet Operationen für attributbasierte uSeg-EPGs (fvAEPg mit fvCrtrn) in Cisco ACI
+type MicroSegEPGClient struct {
+	client *Client
+}
+
+// NewMicroSegEPGClient initialisiert einen neuen MicroSegEPG-Client
+func NewMicroSegEPGClient(client *Client) *MicroSegEPGClient {
+	return &MicroSegEPGClient{
+		client: client,
+	}
+}
+
+// criterionAttributes liefert die Kriterien-Attribute je Klasse, jeweils nach Namen
+func criterionAttributes(c v1alpha1.MicroSegCriterion) map[string]map[string]map[string]string {
+	attrs := map[string]map[string]map[string]string{
+		"fvVmAttr":  {},
+		"fvIpAttr":  {},
+		"fvMacAttr": {},
+	}
+	for _, vm := range c.VMAttributes {
+		attrs["fvVmAttr"][vm.Name] = withAttributes(map[string]string{
+			"name":  vm.Name,
+			"type":  vm.Type,
+			"value": vm.Value,
+		}, map[string]string{
+			"operator": vm.Operator,
+		})
+	}
+	for _, ip := range c.IPAttributes {
+		attrs["fvIpAttr"][ip.Name] = map[string]string{
+			"name": ip.Name,
+			"ip":   ip.IP,
+		}
+	}
+	for _, mac := range c.MACAttributes {
+		attrs["fvMacAttr"][mac.Name] = map[string]string{
+			"name": mac.Name,
+			"mac":  mac.MAC,
+		}
+	}
+	return attrs
+}
+
+// criterionPayload baut die fvCrtrn-Payload auf. observed ist das aktuelle Kriterium oder nil;
+// nicht mehr gewünschte Attribute werden gelöscht.
+func criterionPayload(c v1alpha1.MicroSegCriterion, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	for _, class := range []string{"fvVmAttr", "fvIpAttr", "fvMacAttr"} {
+		wanted := map[string]bool{}
+		for name, attrs := range criterionAttributes(c)[class] {
+			wanted[name] = true
+			payload := withAttributes(attrs, map[string]string{"status": "created,modified"})
+			children = append(children, map[string]interface{}{
+				class: map[string]interface{}{
+					"attributes": payload,
+				},
+			})
+		}
+		children = append(children, deletedChildren(class, "name", wanted, observed.ChildrenOf(class))...)
+	}
+
+	return map[string]interface{}{
+		"fvCrtrn": map[string]interface{}{
+			"attributes": withAttributes(map[string]string{
+				"name":   "default",
+				"status": "created,modified",
+			}, map[string]string{
+				"match": c.Match,
+				"prec":  c.Precedence,
+			}),
+			"children": children,
+		},
+	}
+}
+
+// microSegEPGPayload baut die fvAEPg-Payload der uSeg-EPG auf. observed ist die aktuelle EPG oder nil.
+func microSegEPGPayload(p v1alpha1.MicroSegEPGParameters, status string, observed *ManagedObject) map[string]interface{} {
+	var observedChildren []ManagedObject
+	if observed != nil {
+		observedChildren = observed.Children
+	}
+
+	children := []interface{}{
+		map[string]interface{}{
+			"fvRsBd": map[string]interface{}{
+				"attributes": map[string]string{
+					"tnFvBDName": p.Bd,
+					"status":     "created,modified",
+				},
+			},
+		},
+		criterionPayload(p.Criterion, observed.Child("fvCrtrn")),
+	}
+	children = append(children, domainAttachmentChildren(p.Domains, observedChildren)...)
+	children = append(children, contractRelationChildren(p.Contracts, observedChildren)...)
+
+	return map[string]interface{}{
+		"fvAEPg": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":             TenantEPGDN(p.Tenant, p.AppProfile, p.Name),
+				"name":           p.Name,
+				"descr":          p.Desc,
+				"isAttrBasedEPg": "yes",
+				"status":         status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateMicroSegEPG erstellt eine neue uSeg-EPG in Cisco ACI
+func (c *MicroSegEPGClient) CreateMicroSegEPG(p v1alpha1.MicroSegEPGParameters) error {
+	if err := c.client.PostMO(TenantEPGDN(p.Tenant, p.AppProfile, p.Name), microSegEPGPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der MicroSegEPG: %v", err)
+	}
+
+	log.Println("MicroSegEPG erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateMicroSegEPG aktualisiert eine bestehende uSeg-EPG in Cisco ACI
+func (c *MicroSegEPGClient) UpdateMicroSegEPG(p v1alpha1.MicroSegEPGParameters) error {
+	observed, err := c.ObserveMicroSegEPG(p.Tenant, p.AppProfile, p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(TenantEPGDN(p.Tenant, p.AppProfile, p.Name), microSegEPGPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der MicroSegEPG: %v", err)
+	}
+
+	log.Println("MicroSegEPG erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteMicroSegEPG löscht eine bestehende uSeg-EPG in Cisco ACI
+func (c *MicroSegEPGClient) DeleteMicroSegEPG(tenant, appProfile, name string) error {
+	data := map[string]interface{}{
+		"fvAEPg": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(TenantEPGDN(tenant, appProfile, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der MicroSegEPG: %v", err)
+	}
+
+	log.Println("MicroSegEPG erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveMicroSegEPG liest eine uSeg-EPG samt Kriterium und Relationen aus Cisco ACI.
+// Gibt nil zurück, wenn sie nicht existiert.
+func (c *MicroSegEPGClient) ObserveMicroSegEPG(tenant, appProfile, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(TenantEPGDN(tenant, appProfile, name), "rsp-subtree=full&rsp-prop-include=config-only")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der MicroSegEPG: %w", err)
+	}
+	return mo, nil
+}
+
+// MicroSegEPGUpToDate prüft, ob die beobachtete uSeg-EPG der Spezifikation entspricht
+func MicroSegEPGUpToDate(mo *ManagedObject, p v1alpha1.MicroSegEPGParameters) bool {
+	if mo.Attributes["descr"] != p.Desc || mo.Attributes["isAttrBasedEPg"] != "yes" {
+		return false
+	}
+	if rs := mo.Child("fvRsBd"); rs == nil || rs.Attributes["tnFvBDName"] != p.Bd {
+		return false
+	}
+
+	if !domainAttachmentsMatch(p.Domains, mo) || !contractRelationsMatch(p.Contracts, mo.Children) {
+		return false
+	}
+
+	crtrn := mo.Child("fvCrtrn")
+	if crtrn == nil || !attributesMatch(crtrn.Attributes, withAttributes(nil, map[string]string{
+		"match": p.Criterion.Match,
+		"prec":  p.Criterion.Precedence,
+	})) {
+		return false
+	}
+	for class, byName := range criterionAttributes(p.Criterion) {
+		if len(crtrn.ChildrenOf(class)) != len(byName) {
+			return false
+		}
+		for name, attrs := range byName {
+			observed := crtrn.ChildBy(class, "name", name)
+			if observed == nil || !attributesMatch(observed.Attributes, attrs) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestMicroSegEPGUpToDateDomains(t *testing.T) {
+	p := v1alpha1.MicroSegEPGParameters{
+		Name:       "useg1",
+		Tenant:     "tn1",
+		AppProfile: "ap1",
+		Bd:         "bd1",
+		Domains: []v1alpha1.DomainAssociation{{
+			Domain:              "uni/vmmp-VMware/dom-dvs1",
+			ResolutionImmediacy: "immediate",
+			MicroSegEncap:       "vlan-101",
+			VMM:                 &v1alpha1.VMMDomainOptions{AllowPromiscuous: "reject"},
+		}},
+	}
+
+	observed := func(domAttrs, secp map[string]string) *ManagedObject {
+		dom := ManagedObject{Class: "fvRsDomAtt", Attributes: domAttrs}
+		if secp != nil {
+			dom.Children = []ManagedObject{{Class: "vmmSecP", Attributes: secp}}
+		}
+		return &ManagedObject{
+			Class:      "fvAEPg",
+			Attributes: map[string]string{"name": "useg1", "isAttrBasedEPg": "yes"},
+			Children: []ManagedObject{
+				{Class: "fvRsBd", Attributes: map[string]string{"tnFvBDName": "bd1"}},
+				{Class: "fvCrtrn", Attributes: map[string]string{"name": "default"}},
+				dom,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		mo   *ManagedObject
+		want bool
+	}{
+		"UpToDate": {
+			mo: observed(
+				map[string]string{"tDn": "uni/vmmp-VMware/dom-dvs1", "resImedcy": "immediate", "encap": "vlan-101"},
+				map[string]string{"allowPromiscuous": "reject"},
+			),
+			want: true,
+		},
+		"ResolutionImmediacyChanged": {
+			mo: observed(
+				map[string]string{"tDn": "uni/vmmp-VMware/dom-dvs1", "resImedcy": "lazy", "encap": "vlan-101"},
+				map[string]string{"allowPromiscuous": "reject"},
+			),
+			want: false,
+		},
+		"MicroSegEncapChanged": {
+			mo: observed(
+				map[string]string{"tDn": "uni/vmmp-VMware/dom-dvs1", "resImedcy": "immediate", "encap": "vlan-102"},
+				map[string]string{"allowPromiscuous": "reject"},
+			),
+			want: false,
+		},
+		"SecurityPolicyChanged": {
+			mo: observed(
+				map[string]string{"tDn": "uni/vmmp-VMware/dom-dvs1", "resImedcy": "immediate", "encap": "vlan-101"},
+				map[string]string{"allowPromiscuous": "accept"},
+			),
+			want: false,
+		},
+		"SecurityPolicyMissing": {
+			mo: observed(
+				map[string]string{"tDn": "uni/vmmp-VMware/dom-dvs1", "resImedcy": "immediate", "encap": "vlan-101"},
+				nil,
+			),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := MicroSegEPGUpToDate(tc.mo, p); got != tc.want {
+				t.Errorf("MicroSegEPGUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
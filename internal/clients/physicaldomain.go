@@ -0,0 +1,105 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// PhysicalDomainClient verwaltet Operationen für physische Domänen (physDomP) in Cisco ACI
+type PhysicalDomainClient struct {
+	client *Client
+}
+
+// NewPhysicalDomainClient initialisiert einen neuen PhysicalDomain-Client
+func NewPhysicalDomainClient(client *Client) *PhysicalDomainClient {
+	return &PhysicalDomainClient{
+		client: client,
+	}
+}
+
+// PhysicalDomainDN liefert den DN einer physischen Domäne
+func PhysicalDomainDN(name string) string {
+	return fmt.Sprintf("uni/phys-%s", name)
+}
+
+// physicalDomainPayload baut die physDomP-Payload inklusive der Pool-Relation auf
+func physicalDomainPayload(p v1alpha1.PhysicalDomainParameters, status string, observed *ManagedObject) map[string]interface{} {
+	pools := []string{}
+	if p.VLANPool != "" {
+		pools = append(pools, p.VLANPool)
+	}
+
+	return map[string]interface{}{
+		"physDomP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     PhysicalDomainDN(p.Name),
+				"name":   p.Name,
+				"status": status,
+			},
+			"children": relationChildren("infraRsVlanNs", "tDn", pools, observed.ChildrenOf("infraRsVlanNs")),
+		},
+	}
+}
+
+// CreatePhysicalDomain erstellt eine neue physische Domäne in Cisco ACI
+func (c *PhysicalDomainClient) CreatePhysicalDomain(p v1alpha1.PhysicalDomainParameters) error {
+	if err := c.client.PostMO(PhysicalDomainDN(p.Name), physicalDomainPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der physischen Domäne: %v", err)
+	}
+
+	log.Println("Physische Domäne erfolgreich erstellt!")
+	return nil
+}
+
+// UpdatePhysicalDomain aktualisiert eine bestehende physische Domäne in Cisco ACI
+func (c *PhysicalDomainClient) UpdatePhysicalDomain(p v1alpha1.PhysicalDomainParameters) error {
+	observed, err := c.ObservePhysicalDomain(p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(PhysicalDomainDN(p.Name), physicalDomainPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der physischen Domäne: %v", err)
+	}
+
+	log.Println("Physische Domäne erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeletePhysicalDomain löscht eine bestehende physische Domäne in Cisco ACI
+func (c *PhysicalDomainClient) DeletePhysicalDomain(name string) error {
+	data := map[string]interface{}{
+		"physDomP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(PhysicalDomainDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der physischen Domäne: %v", err)
+	}
+
+	log.Println("Physische Domäne erfolgreich gelöscht!")
+	return nil
+}
+
+// ObservePhysicalDomain liest eine physische Domäne samt Relationen aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *PhysicalDomainClient) ObservePhysicalDomain(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(PhysicalDomainDN(name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der physischen Domäne: %w", err)
+	}
+	return mo, nil
+}
+
+// PhysicalDomainUpToDate prüft, ob die beobachtete physische Domäne der Spezifikation entspricht
+func PhysicalDomainUpToDate(mo *ManagedObject, p v1alpha1.PhysicalDomainParameters) bool {
+	pools := []string{}
+	if p.VLANPool != "" {
+		pools = append(pools, p.VLANPool)
+	}
+	return relationsMatch("infraRsVlanNs", "tDn", pools, mo.Children)
+}
@@ -0,0 +1,81 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+)
+
+// policyClient bündelt Erstellen, Aktualisieren, Löschen und Beobachten von Policies, die nur aus
+// den Attributen eines einzelnen Objekts ohne Kindobjekte bestehen. P ist der Parametertyp der Policy.
+type policyClient[P any] struct {
+	client *Client
+
+	// class ist die ACI-Klasse der Policy, z.B. cdpIfPol
+	class string
+	// label benennt die Policy in Log- und Fehlermeldungen, z.B. CDP-Policy
+	label string
+	// dn liefert den DN der Policy mit dem angegebenen Namen
+	dn func(name string) string
+	// attributes liefert die konfigurierbaren Attribute der Policy
+	attributes func(p P) map[string]string
+}
+
+// payload baut die Payload der Policy mit dem angegebenen Status auf
+func (c *policyClient[P]) payload(name string, p P, status string) map[string]interface{} {
+	attrs := c.attributes(p)
+	attrs["dn"] = c.dn(name)
+	attrs["status"] = status
+
+	return map[string]interface{}{
+		c.class: map[string]interface{}{
+			"attributes": attrs,
+		},
+	}
+}
+
+// create erstellt eine neue Policy in Cisco ACI
+func (c *policyClient[P]) create(name string, p P) error {
+	if err := c.client.PostMO(c.dn(name), c.payload(name, p, "created")); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der %s: %v", c.label, err)
+	}
+
+	log.Printf("%s erfolgreich erstellt!", c.label)
+	return nil
+}
+
+// update aktualisiert eine bestehende Policy in Cisco ACI
+func (c *policyClient[P]) update(name string, p P) error {
+	if err := c.client.PostMO(c.dn(name), c.payload(name, p, "modified")); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der %s: %v", c.label, err)
+	}
+
+	log.Printf("%s erfolgreich aktualisiert!", c.label)
+	return nil
+}
+
+// delete löscht eine bestehende Policy in Cisco ACI
+func (c *policyClient[P]) delete(name string) error {
+	data := map[string]interface{}{
+		c.class: map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(c.dn(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der %s: %v", c.label, err)
+	}
+
+	log.Printf("%s erfolgreich gelöscht!", c.label)
+	return nil
+}
+
+// observe liest eine Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *policyClient[P]) observe(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(c.dn(name), "")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der %s: %w", c.label, err)
+	}
+	return mo, nil
+}
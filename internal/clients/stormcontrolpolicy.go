@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"fmt"
+	"strconv"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// StormControlPolicyClient verwaltet Operationen für Storm-Control-Policies (stormctrlIfPol) in Cisco ACI
+type StormControlPolicyClient struct {
+	policy *policyClient[v1alpha1.StormControlPolicyParameters]
+}
+
+// NewStormControlPolicyClient initialisiert einen neuen StormControlPolicy-Client
+func NewStormControlPolicyClient(client *Client) *StormControlPolicyClient {
+	return &StormControlPolicyClient{
+		policy: &policyClient[v1alpha1.StormControlPolicyParameters]{
+			client:     client,
+			class:      "stormctrlIfPol",
+			label:      "Storm-Control-Policy",
+			dn:         StormControlPolicyDN,
+			attributes: stormControlPolicyAttributes,
+		},
+	}
+}
+
+// StormControlPolicyDN liefert den DN einer Storm-Control-Policy
+func StormControlPolicyDN(name string) string {
+	return fmt.Sprintf("uni/infra/stormctrlifp-%s", name)
+}
+
+// sameRate vergleicht zwei Prozentwerte numerisch, da der APIC z.B. "50" als "50.000000" zurückliefert
+func sameRate(observed, desired string) bool {
+	o, errO := strconv.ParseFloat(observed, 64)
+	d, errD := strconv.ParseFloat(desired, 64)
+	if errO != nil || errD != nil {
+		return observed == desired
+	}
+	return o == d
+}
+
+// stormControlPolicyAttributes liefert die konfigurierbaren stormctrlIfPol-Attribute; leere optionale Werte werden weggelassen
+func stormControlPolicyAttributes(p v1alpha1.StormControlPolicyParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"rate":            p.Rate,
+		"burstRate":       p.BurstRate,
+		"stormCtrlAction": p.Action,
+	})
+}
+
+// CreateStormControlPolicy erstellt eine neue Storm-Control-Policy in Cisco ACI
+func (c *StormControlPolicyClient) CreateStormControlPolicy(p v1alpha1.StormControlPolicyParameters) error {
+	return c.policy.create(p.Name, p)
+}
+
+// UpdateStormControlPolicy aktualisiert eine bestehende Storm-Control-Policy in Cisco ACI
+func (c *StormControlPolicyClient) UpdateStormControlPolicy(p v1alpha1.StormControlPolicyParameters) error {
+	return c.policy.update(p.Name, p)
+}
+
+// DeleteStormControlPolicy löscht eine bestehende Storm-Control-Policy in Cisco ACI
+func (c *StormControlPolicyClient) DeleteStormControlPolicy(name string) error {
+	return c.policy.delete(name)
+}
+
+// ObserveStormControlPolicy liest eine Storm-Control-Policy aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *StormControlPolicyClient) ObserveStormControlPolicy(name string) (*ManagedObject, error) {
+	return c.policy.observe(name)
+}
+
+// StormControlPolicyUpToDate prüft, ob die beobachtete Storm-Control-Policy der Spezifikation entspricht
+func StormControlPolicyUpToDate(mo *ManagedObject, p v1alpha1.StormControlPolicyParameters) bool {
+	desired := stormControlPolicyAttributes(p)
+	for _, key := range []string{"rate", "burstRate"} {
+		if value, ok := desired[key]; ok {
+			if !sameRate(mo.Attributes[key], value) {
+				return false
+			}
+			delete(desired, key)
+		}
+	}
+	return attributesMatch(mo.Attributes, desired)
+}
@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// TenantClient verwaltet Operationen für Tenants (fvTenant) in Cisco ACI
+type TenantClient struct {
+	client *Client
+}
+
+// NewTenantClient initialisiert einen neuen Tenant-Client
+func NewTenantClient(client *Client) *TenantClient {
+	return &TenantClient{
+		client: client,
+	}
+}
+
+// TenantDN liefert den DN eines Tenants
+func TenantDN(name string) string {
+	return fmt.Sprintf("uni/tn-%s", name)
+}
+
+// tenantPayload baut die fvTenant-Payload mit dem angegebenen Status auf
+func tenantPayload(p v1alpha1.TenantParameters, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"fvTenant": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":         TenantDN(p.Name),
+				"name":       p.Name,
+				"descr":      p.Desc,
+				"nameAlias":  p.NameAlias,
+				"annotation": p.Annotation,
+				"status":     status,
+			},
+		},
+	}
+}
+
+// CreateTenant erstellt einen neuen Tenant in Cisco ACI
+func (c *TenantClient) CreateTenant(p v1alpha1.TenantParameters) error {
+	if err := c.client.PostMO(TenantDN(p.Name), tenantPayload(p, "created")); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des Tenants: %v", err)
+	}
+
+	log.Println("Tenant erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateTenant aktualisiert einen bestehenden Tenant in Cisco ACI
+func (c *TenantClient) UpdateTenant(p v1alpha1.TenantParameters) error {
+	if err := c.client.PostMO(TenantDN(p.Name), tenantPayload(p, "modified")); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des Tenants: %v", err)
+	}
+
+	log.Println("Tenant erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteTenant löscht einen bestehenden Tenant in Cisco ACI
+func (c *TenantClient) DeleteTenant(name string) error {
+	data := map[string]interface{}{
+		"fvTenant": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(TenantDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des Tenants: %v", err)
+	}
+
+	log.Println("Tenant erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveTenant liest einen Tenant aus Cisco ACI. Gibt nil zurück, wenn er nicht existiert.
+func (c *TenantClient) ObserveTenant(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(TenantDN(name), "")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des Tenants: %w", err)
+	}
+	return mo, nil
+}
@@ -1,9 +1,12 @@
 package clients
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
 )
 
 // TenantEPGClient verwaltet Operationen für End Point Groups (EPGs) in Cisco ACI
@@ -18,110 +21,246 @@ func NewTenantEPGClient(client *Client) *TenantEPGClient {
 	}
 }
 
-// CreateTenantEPG erstellt eine neue End Point Group (EPG) in Cisco ACI
-func (c *TenantEPGClient) CreateTenantEPG(tenant, appProfile, epgName, bd, desc string) error {
-	url := fmt.Sprintf("/api/node/mo/uni/tn-%s/ap-%s/epg-%s.json", tenant, appProfile, epgName)
+// TenantEPGDN liefert den DN einer End Point Group
+func TenantEPGDN(tenant, appProfile, epgName string) string {
+	return fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", tenant, appProfile, epgName)
+}
 
-	// Definiere die Payload-Struktur für die API-Anfrage
-	data := map[string]interface{}{
-		"fvAEPg": map[string]interface{}{
-			"attributes": map[string]string{
-				"dn":     fmt.Sprintf("uni/tn-%s/ap-%s/epg-%s", tenant, appProfile, epgName),
-				"prio":   "level3",
-				"name":   epgName,
-				"descr":  desc,
-				"rn":     fmt.Sprintf("epg-%s", epgName),
-				"status": "created",
-			},
-			"children": []interface{}{
-				map[string]interface{}{
-					"fvRsBd": map[string]interface{}{
-						"attributes": map[string]string{
-							"tnFvBDName": bd,
-							"status":     "created,modified",
-						},
-						"children": []interface{}{},
-					},
+// tenantEPGDNPattern beschreibt den DN einer EPG, z.B. uni/tn-prod/ap-web/epg-frontend
+var tenantEPGDNPattern = regexp.MustCompile(`^uni/tn-([^/]+)/ap-([^/]+)/epg-([^/]+)$`)
+
+// TenantEPGFromExternalName übernimmt Tenant, Application Profile und Namen aus dem External Name
+// der Managed Resource. Ein DN legt alle drei fest, ein RN (epg-<name>) nur den Namen; Tenant und
+// Application Profile kommen dann aus der Spezifikation. Ohne External Name bleibt p unverändert.
+func TenantEPGFromExternalName(externalName string, p v1alpha1.TenantEPGParameters) (v1alpha1.TenantEPGParameters, error) {
+	switch {
+	case externalName == "":
+		return p, nil
+	case tenantEPGDNPattern.MatchString(externalName):
+		m := tenantEPGDNPattern.FindStringSubmatch(externalName)
+		p.Tenant, p.AppProfile, p.Name = m[1], m[2], m[3]
+		return p, nil
+	case strings.HasPrefix(externalName, "epg-") && !strings.Contains(externalName, "/"):
+		p.Name = strings.TrimPrefix(externalName, "epg-")
+		return p, nil
+	}
+	return p, fmt.Errorf("ungültiger External Name %q: erwartet wird ein DN (uni/tn-<tenant>/ap-<ap>/epg-<name>) oder RN (epg-<name>)", externalName)
+}
+
+// tenantEPGChildren baut die Kindobjekte der EPG auf. observed enthält die aktuellen Kindobjekte,
+// damit nicht mehr gewünschte Contract-Relationen entfernt werden können.
+func tenantEPGChildren(p v1alpha1.TenantEPGParameters, observed []ManagedObject) []interface{} {
+	children := []interface{}{
+		map[string]interface{}{
+			"fvRsBd": map[string]interface{}{
+				"attributes": map[string]string{
+					"tnFvBDName": p.Bd,
+					"status":     "created,modified",
 				},
+				"children": []interface{}{},
 			},
 		},
 	}
+	children = append(children, contractRelationChildren(p.Contracts, observed)...)
+	children = append(children, staticPathChildren(p.StaticPaths, observed)...)
+	return append(children, domainAttachmentChildren(p.Domains, observed)...)
+}
 
-	// Logge Anfrage-Details zum Debuggen
-	log.Printf("Sende POST-Anfrage an %s mit Daten: %v\n", url, data)
+// staticPathChildren liefert die fvRsPathAtt-Kindobjekte: gewünschte Bindings werden angelegt
+// bzw. aktualisiert, nicht mehr gewünschte gelöscht
+func staticPathChildren(paths []v1alpha1.StaticPath, observed []ManagedObject) []interface{} {
+	children := []interface{}{}
+	wanted := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		wanted[path.Path] = true
+		attrs := withAttributes(map[string]string{
+			"tDn":    path.Path,
+			"encap":  path.Encap,
+			"status": "created,modified",
+		}, map[string]string{
+			"mode":        path.Mode,
+			"instrImedcy": path.Immediacy,
+		})
+		children = append(children, map[string]interface{}{
+			"fvRsPathAtt": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	return append(children, deletedChildren("fvRsPathAtt", "tDn", wanted, observed)...)
+}
 
-	// Führe die Anfrage mit der DoRequest-Funktion des Clients aus
-	respBody, err := c.client.DoRequest("POST", url, data)
-	if err != nil {
-		return fmt.Errorf("Fehler beim Erstellen der TenantEPG: %v", err)
+// domainAttachmentAttributes liefert die konfigurierbaren fvRsDomAtt-Attribute einer Domäne;
+// leere optionale Werte werden weggelassen
+func domainAttachmentAttributes(dom v1alpha1.DomainAssociation) map[string]string {
+	attrs := withAttributes(map[string]string{
+		"tDn": dom.Domain,
+	}, map[string]string{
+		"resImedcy":    dom.ResolutionImmediacy,
+		"instrImedcy":  dom.DeploymentImmediacy,
+		"primaryEncap": dom.PrimaryEncap,
+		"encap":        dom.MicroSegEncap,
+	})
+	if dom.VMM != nil && dom.VMM.CustomEPGName != "" {
+		attrs["customEpgName"] = dom.VMM.CustomEPGName
+	}
+	return attrs
+}
+
+// vmmSecPAttributes liefert die Attribute der Port-Group-Sicherheitsrichtlinie (vmmSecP) einer VMM-Domäne
+func vmmSecPAttributes(vmm *v1alpha1.VMMDomainOptions) map[string]string {
+	return withAttributes(nil, map[string]string{
+		"allowPromiscuous": vmm.AllowPromiscuous,
+		"forgedTransmits":  vmm.ForgedTransmits,
+		"macChanges":       vmm.MacChanges,
+	})
+}
+
+// domainAttachmentChildren liefert die fvRsDomAtt-Kindobjekte: gewünschte Domänen werden angelegt
+// bzw. aktualisiert, nicht mehr gewünschte gelöscht
+func domainAttachmentChildren(domains []v1alpha1.DomainAssociation, observed []ManagedObject) []interface{} {
+	children := []interface{}{}
+	wanted := make(map[string]bool, len(domains))
+	for _, dom := range domains {
+		wanted[dom.Domain] = true
+		attrs := domainAttachmentAttributes(dom)
+		attrs["status"] = "created,modified"
+
+		domChildren := []interface{}{}
+		if dom.VMM != nil {
+			secp := vmmSecPAttributes(dom.VMM)
+			secp["status"] = "created,modified"
+			domChildren = append(domChildren, map[string]interface{}{
+				"vmmSecP": map[string]interface{}{
+					"attributes": secp,
+				},
+			})
+		}
+
+		children = append(children, map[string]interface{}{
+			"fvRsDomAtt": map[string]interface{}{
+				"attributes": attrs,
+				"children":   domChildren,
+			},
+		})
 	}
+	return append(children, deletedChildren("fvRsDomAtt", "tDn", wanted, observed)...)
+}
 
-	// Logge die Antwort zum Debuggen
-	log.Printf("Antwort: %s\n", string(respBody))
+// domainAttachmentsMatch prüft, ob die beobachteten fvRsDomAtt-Relationen samt ihren Attributen und
+// der vmmSecP-Richtlinie den gewünschten Domänen entsprechen
+func domainAttachmentsMatch(domains []v1alpha1.DomainAssociation, observed *ManagedObject) bool {
+	if len(observed.ChildrenOf("fvRsDomAtt")) != len(domains) {
+		return false
+	}
+	for _, dom := range domains {
+		rs := observed.ChildBy("fvRsDomAtt", "tDn", dom.Domain)
+		if rs == nil || !attributesMatch(rs.Attributes, domainAttachmentAttributes(dom)) {
+			return false
+		}
+		if dom.VMM == nil {
+			continue
+		}
+		if secp := vmmSecPAttributes(dom.VMM); len(secp) > 0 {
+			if current := rs.Child("vmmSecP"); current == nil || !attributesMatch(current.Attributes, secp) {
+				return false
+			}
+		}
+	}
+	return true
+}
 
-	// Parse die Antwort, um nach Fehlern zu suchen
-	var result map[string]interface{}
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return fmt.Errorf("Fehler beim Unmarshalen der Antwort: %v", err)
+// tenantEPGOptionalAttributes ordnet die optionalen fvAEPg-Attribute den Feldern der Spezifikation zu
+func tenantEPGOptionalAttributes(p *v1alpha1.TenantEPGParameters) map[string]**string {
+	return map[string]**string{
+		"prio":         &p.Prio,
+		"prefGrMemb":   &p.PrefGrMemb,
+		"floodOnEncap": &p.FloodOnEncap,
+		"pcEnfPref":    &p.PcEnfPref,
+		"matchT":       &p.MatchT,
 	}
+}
 
-	// Überprüfe auf API-Fehler in der Antwort
-	if imdata, ok := result["imdata"].([]interface{}); ok && len(imdata) > 0 {
-		if errorInfo, found := imdata[0].(map[string]interface{})["error"]; found {
-			attrs := errorInfo.(map[string]interface{})["attributes"].(map[string]interface{})
-			return fmt.Errorf("Fehler beim Erstellen der TenantEPG: code=%s, text=%s", attrs["code"], attrs["text"])
+// tenantEPGAttributes liefert die konfigurierbaren fvAEPg-Attribute; nicht gesetzte optionale
+// Felder werden weggelassen, damit der APIC seinen aktuellen Wert behält
+func tenantEPGAttributes(p v1alpha1.TenantEPGParameters) map[string]string {
+	attrs := map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}
+	for key, field := range tenantEPGOptionalAttributes(&p) {
+		if *field != nil {
+			attrs[key] = **field
 		}
 	}
+	return attrs
+}
 
-	log.Println("TenantEPG erfolgreich erstellt!")
-	return nil
+// LateInitializeTenantEPG übernimmt die Werte nicht gesetzter optionaler Felder aus der beobachteten
+// EPG. Gibt true zurück, wenn mindestens ein Feld gesetzt wurde.
+func LateInitializeTenantEPG(p *v1alpha1.TenantEPGParameters, mo *ManagedObject) bool {
+	changed := false
+	for key, field := range tenantEPGOptionalAttributes(p) {
+		value, ok := mo.Attributes[key]
+		if *field != nil || !ok {
+			continue
+		}
+		*field = &value
+		changed = true
+	}
+	return changed
 }
 
-// UpdateTenantEPG aktualisiert eine bestehende End Point Group (EPG) in Cisco ACI
-func (c *TenantEPGClient) UpdateTenantEPG(tenant, appProfile, epgName, bd, desc string) error {
-	url := fmt.Sprintf("/api/node/mo/uni/tn-%s/ap-%s/epg-%s.json", tenant, appProfile, epgName)
+// CreateTenantEPG erstellt eine neue End Point Group (EPG) in Cisco ACI
+func (c *TenantEPGClient) CreateTenantEPG(p v1alpha1.TenantEPGParameters) error {
+	dn := TenantEPGDN(p.Tenant, p.AppProfile, p.Name)
 
-	// Definiere die Payload-Struktur für die Update-Anfrage
+	// Definiere die Payload-Struktur für die API-Anfrage
 	data := map[string]interface{}{
 		"fvAEPg": map[string]interface{}{
-			"attributes": map[string]string{
-				"descr":  desc,
-				"name":   epgName,
-				"status": "modified",
-			},
-			"children": []interface{}{
-				map[string]interface{}{
-					"fvRsBd": map[string]interface{}{
-						"attributes": map[string]string{
-							"tnFvBDName": bd,
-							"status":     "created,modified",
-						},
-						"children": []interface{}{},
-					},
-				},
-			},
+			"attributes": withAttributes(tenantEPGAttributes(p), map[string]string{
+				"dn":     dn,
+				"rn":     fmt.Sprintf("epg-%s", p.Name),
+				"status": "created",
+			}),
+			"children": tenantEPGChildren(p, nil),
 		},
 	}
 
-	log.Printf("Sende POST-Anfrage an %s mit Daten: %v\n", url, data)
+	if err := c.client.PostMO(dn, data); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der TenantEPG: %v", err)
+	}
+
+	log.Println("TenantEPG erfolgreich erstellt!")
+	return nil
+}
 
-	respBody, err := c.client.DoRequest("POST", url, data)
+// UpdateTenantEPG aktualisiert eine bestehende End Point Group (EPG) in Cisco ACI
+func (c *TenantEPGClient) UpdateTenantEPG(p v1alpha1.TenantEPGParameters) error {
+	dn := TenantEPGDN(p.Tenant, p.AppProfile, p.Name)
+
+	// Aktuelle Relationen lesen, um entfernte Contracts löschen zu können
+	current, err := c.ObserveTenantEPG(p.Tenant, p.AppProfile, p.Name)
 	if err != nil {
-		return fmt.Errorf("Fehler beim Aktualisieren der TenantEPG: %v", err)
+		return err
+	}
+	var observed []ManagedObject
+	if current != nil {
+		observed = current.Children
 	}
 
-	log.Printf("Antwort: %s\n", string(respBody))
-
-	var result map[string]interface{}
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return fmt.Errorf("Fehler beim Unmarshalen der Antwort: %v", err)
+	// Definiere die Payload-Struktur für die Update-Anfrage
+	data := map[string]interface{}{
+		"fvAEPg": map[string]interface{}{
+			"attributes": withAttributes(tenantEPGAttributes(p), map[string]string{
+				"status": "modified",
+			}),
+			"children": tenantEPGChildren(p, observed),
+		},
 	}
 
-	if imdata, ok := result["imdata"].([]interface{}); ok && len(imdata) > 0 {
-		if errorInfo, found := imdata[0].(map[string]interface{})["error"]; found {
-			attrs := errorInfo.(map[string]interface{})["attributes"].(map[string]interface{})
-			return fmt.Errorf("Fehler beim Aktualisieren der TenantEPG: code=%s, text=%s", attrs["code"], attrs["text"])
-		}
+	if err := c.client.PostMO(dn, data); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der TenantEPG: %v", err)
 	}
 
 	log.Println("TenantEPG erfolgreich aktualisiert!")
@@ -130,8 +269,6 @@ func (c *TenantEPGClient) UpdateTenantEPG(tenant, appProfile, epgName, bd, desc
 
 // DeleteTenantEPG löscht eine bestehende End Point Group (EPG) in Cisco ACI
 func (c *TenantEPGClient) DeleteTenantEPG(tenant, appProfile, epgName string) error {
-	url := fmt.Sprintf("/api/node/mo/uni/tn-%s/ap-%s/epg-%s.json", tenant, appProfile, epgName)
-
 	data := map[string]interface{}{
 		"fvAEPg": map[string]interface{}{
 			"attributes": map[string]string{
@@ -140,51 +277,104 @@ func (c *TenantEPGClient) DeleteTenantEPG(tenant, appProfile, epgName string) er
 		},
 	}
 
-	log.Printf("Sende POST-Anfrage an %s mit Daten: %v\n", url, data)
-
-	respBody, err := c.client.DoRequest("POST", url, data)
-	if err != nil {
+	if err := c.client.PostMO(TenantEPGDN(tenant, appProfile, epgName), data); err != nil {
 		return fmt.Errorf("Fehler beim Löschen der TenantEPG: %v", err)
 	}
 
-	log.Printf("Antwort: %s\n", string(respBody))
+	log.Println("TenantEPG erfolgreich gelöscht!")
+	return nil
+}
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return fmt.Errorf("Fehler beim Unmarshalen der Antwort: %v", err)
+// ObserveTenantEPG liest eine End Point Group (EPG) samt fvRsBd, weiteren Relationen und dem
+// Health Score aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *TenantEPGClient) ObserveTenantEPG(tenantName, appProfileName, epgName string) (*ManagedObject, error) {
+	dn := TenantEPGDN(tenantName, appProfileName, epgName)
+	mo, err := c.client.GetMO(dn, "rsp-subtree=children&rsp-subtree-include=health")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der TenantEPG: %w", err)
+	}
+	if mo == nil {
+		return nil, nil
 	}
 
-	if imdata, ok := result["imdata"].([]interface{}); ok && len(imdata) > 0 {
-		if errorInfo, found := imdata[0].(map[string]interface{})["error"]; found {
-			attrs := errorInfo.(map[string]interface{})["attributes"].(map[string]interface{})
-			return fmt.Errorf("Fehler beim Löschen der TenantEPG: code=%s, text=%s", attrs["code"], attrs["text"])
+	// Die vmmSecP-Richtlinie hängt unter fvRsDomAtt und wird für VMM-Domänen separat gelesen
+	for i := range mo.Children {
+		rs := &mo.Children[i]
+		if rs.Class != "fvRsDomAtt" || !strings.HasPrefix(rs.Attributes["tDn"], "uni/vmmp-") {
+			continue
+		}
+		dom, err := c.client.GetMO(fmt.Sprintf("%s/rsdomAtt-[%s]", dn, rs.Attributes["tDn"]), "rsp-subtree=children&rsp-prop-include=config-only")
+		if err != nil {
+			return nil, fmt.Errorf("Fehler beim Beobachten der TenantEPG: %w", err)
+		}
+		if dom != nil {
+			rs.Children = dom.Children
 		}
 	}
+	return mo, nil
+}
 
-	log.Println("TenantEPG erfolgreich gelöscht!")
-	return nil
+// TenantEPGAtProvider liefert den beobachteten Zustand einer EPG für status.atProvider
+func TenantEPGAtProvider(mo *ManagedObject) v1alpha1.TenantEPGObservation {
+	obs := v1alpha1.TenantEPGObservation{
+		DN:               mo.Attributes["dn"],
+		PcTag:            mo.Attributes["pcTag"],
+		Scope:            mo.Attributes["scope"],
+		ConfigIssues:     mo.Attributes["configIssues"],
+		DeploymentStatus: mo.Attributes["configSt"],
+		StaticPaths:      tenantEPGStaticPaths(mo),
+	}
+	if rs := mo.Child("fvRsBd"); rs != nil {
+		obs.BdState = rs.Attributes["state"]
+		obs.BdTargetClass = rs.Attributes["tCl"]
+	}
+	if health := mo.Child("healthInst"); health != nil {
+		obs.HealthScore = health.Attributes["cur"]
+	}
+	return obs
 }
 
-// ObserveTenantEPG überprüft, ob eine spezifische TenantEPG existiert und gibt ihren Status zurück
-func (c *TenantEPGClient) ObserveTenantEPG(tenantName, appProfileName, epgName string) (bool, error) {
-	endpoint := fmt.Sprintf("/api/node/mo/uni/tn-%s/ap-%s/epg-%s.json", tenantName, appProfileName, epgName)
-	response, err := c.client.DoRequest("GET", endpoint, nil)
-	if err != nil {
-		return false, fmt.Errorf("Fehler beim Beobachten der TenantEPG: %w", err)
+// tenantEPGStaticPaths liefert die Static Path Bindings (fvRsPathAtt) einer beobachteten EPG
+func tenantEPGStaticPaths(mo *ManagedObject) []v1alpha1.StaticPathObservation {
+	var paths []v1alpha1.StaticPathObservation
+	for _, rs := range mo.ChildrenOf("fvRsPathAtt") {
+		paths = append(paths, v1alpha1.StaticPathObservation{
+			Path:      rs.Attributes["tDn"],
+			Encap:     rs.Attributes["encap"],
+			Mode:      rs.Attributes["mode"],
+			Immediacy: rs.Attributes["instrImedcy"],
+			State:     rs.Attributes["state"],
+		})
 	}
+	return paths
+}
 
-	// Parse die Antwortdaten in eine Map, um den Status zu analysieren
-	var result map[string]interface{}
-	if err := json.Unmarshal(response, &result); err != nil {
-		return false, fmt.Errorf("Fehler beim Parsen der Antwort: %w", err)
+// TenantEPGUpToDate prüft, ob die beobachtete EPG der Spezifikation entspricht
+func TenantEPGUpToDate(mo *ManagedObject, p v1alpha1.TenantEPGParameters) bool {
+	if !attributesMatch(mo.Attributes, tenantEPGAttributes(p)) {
+		return false
+	}
+	if rs := mo.Child("fvRsBd"); rs == nil || rs.Attributes["tnFvBDName"] != p.Bd {
+		return false
+	}
+	if !contractRelationsMatch(p.Contracts, mo.Children) {
+		return false
 	}
 
-	// Überprüfe, ob die TenantEPG in den Daten enthalten ist
-	imdata, ok := result["imdata"].([]interface{})
-	if !ok || len(imdata) == 0 {
-		return false, fmt.Errorf("TenantEPG %s nicht gefunden in Tenant %s und Application Profile %s", epgName, tenantName, appProfileName)
+	if len(mo.ChildrenOf("fvRsPathAtt")) != len(p.StaticPaths) {
+		return false
+	}
+	for _, path := range p.StaticPaths {
+		rs := mo.ChildBy("fvRsPathAtt", "tDn", path.Path)
+		if rs == nil || !attributesMatch(rs.Attributes, withAttributes(map[string]string{
+			"encap": path.Encap,
+		}, map[string]string{
+			"mode":        path.Mode,
+			"instrImedcy": path.Immediacy,
+		})) {
+			return false
+		}
 	}
 
-	return true, nil
+	return domainAttachmentsMatch(p.Domains, mo)
 }
-
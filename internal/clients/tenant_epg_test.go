@@ -0,0 +1,210 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+const vmmDomain = "uni/vmmp-VMware/dom-dvs1"
+
+func tenantEPGParameters() v1alpha1.TenantEPGParameters {
+	return v1alpha1.TenantEPGParameters{
+		Name:       "web",
+		Desc:       "web servers",
+		Tenant:     "tn1",
+		AppProfile: "ap1",
+		Bd:         "bd1",
+		Contracts:  v1alpha1.ContractRelations{Consumed: []string{"db"}},
+		StaticPaths: []v1alpha1.StaticPath{
+			{Path: "topology/pod-1/paths-101/pathep-[eth1/1]", Encap: "vlan-10", Mode: "regular"},
+		},
+		Domains: []v1alpha1.DomainAssociation{
+			{Domain: "uni/phys-phys1"},
+			{
+				Domain:              vmmDomain,
+				ResolutionImmediacy: "pre-provision",
+				VMM:                 &v1alpha1.VMMDomainOptions{ForgedTransmits: "reject", CustomEPGName: "web-pg"},
+			},
+		},
+	}
+}
+
+func observedTenantEPG() *ManagedObject {
+	return &ManagedObject{
+		Class:      "fvAEPg",
+		Attributes: map[string]string{"name": "web", "descr": "web servers", "prio": "unspecified"},
+		Children: []ManagedObject{
+			{Class: "fvRsBd", Attributes: map[string]string{"tnFvBDName": "bd1"}},
+			{Class: "fvRsCons", Attributes: map[string]string{"tnVzBrCPName": "db"}},
+			{Class: "fvRsPathAtt", Attributes: map[string]string{
+				"tDn": "topology/pod-1/paths-101/pathep-[eth1/1]", "encap": "vlan-10", "mode": "regular",
+			}},
+			{Class: "fvRsDomAtt", Attributes: map[string]string{"tDn": "uni/phys-phys1", "resImedcy": "immediate"}},
+			{
+				Class:      "fvRsDomAtt",
+				Attributes: map[string]string{"tDn": vmmDomain, "resImedcy": "pre-provision", "customEpgName": "web-pg"},
+				Children: []ManagedObject{
+					{Class: "vmmSecP", Attributes: map[string]string{"forgedTransmits": "reject", "macChanges": "reject"}},
+				},
+			},
+		},
+	}
+}
+
+func TestTenantEPGUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		modify func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) {},
+			want:   true,
+		},
+		"DescriptionChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Desc = "other" },
+			want:   false,
+		},
+		"BridgeDomainChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Bd = "bd2" },
+			want:   false,
+		},
+		"ContractRemoved": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Contracts.Consumed = nil },
+			want:   false,
+		},
+		"StaticPathEncapChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.StaticPaths[0].Encap = "vlan-11" },
+			want:   false,
+		},
+		"DomainRemoved": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Domains = p.Domains[1:] },
+			want:   false,
+		},
+		"DomainImmediacyChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) {
+				p.Domains[0].DeploymentImmediacy = "immediate"
+			},
+			want: false,
+		},
+		"PrimaryEncapChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Domains[1].PrimaryEncap = "vlan-20" },
+			want:   false,
+		},
+		"CustomEPGNameChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Domains[1].VMM.CustomEPGName = "web-pg2" },
+			want:   false,
+		},
+		"SecurityPolicyChanged": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { p.Domains[1].VMM.MacChanges = "accept" },
+			want:   false,
+		},
+		"SecurityPolicyNotObserved": {
+			modify: func(mo *ManagedObject, p *v1alpha1.TenantEPGParameters) { mo.Children[4].Children = nil },
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mo := observedTenantEPG()
+			p := tenantEPGParameters()
+			tc.modify(mo, &p)
+			if got := TenantEPGUpToDate(mo, p); got != tc.want {
+				t.Errorf("TenantEPGUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestObserveTenantEPGReadsVMMSecurityPolicy(t *testing.T) {
+	epgDN := TenantEPGDN("tn1", "ap1", "web")
+	responses := map[string]string{
+		fmt.Sprintf("/api/node/mo/%s.json", epgDN): `{"imdata":[{"fvAEPg":{"attributes":{"name":"web"},"children":[
+			{"fvRsDomAtt":{"attributes":{"tDn":"uni/phys-phys1"}}},
+			{"fvRsDomAtt":{"attributes":{"tDn":"` + vmmDomain + `"}}}]}}]}`,
+		fmt.Sprintf("/api/node/mo/%s/rsdomAtt-[%s].json", epgDN, vmmDomain): `{"imdata":[{"fvRsDomAtt":{"attributes":{"tDn":"` + vmmDomain + `"},"children":[
+			{"vmmSecP":{"attributes":{"forgedTransmits":"reject"}}}]}}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			body = `{"imdata":[]}`
+		}
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "admin", "secret", false)
+	client.Token = "token"
+
+	mo, err := NewTenantEPGClient(client).ObserveTenantEPG("tn1", "ap1", "web")
+	if err != nil {
+		t.Fatalf("ObserveTenantEPG() error = %v", err)
+	}
+	if phys := mo.ChildBy("fvRsDomAtt", "tDn", "uni/phys-phys1"); phys == nil || len(phys.Children) != 0 {
+		t.Errorf("physical domain attachment = %+v, want no children", phys)
+	}
+	secp := mo.ChildBy("fvRsDomAtt", "tDn", vmmDomain).Child("vmmSecP")
+	if secp == nil || secp.Attributes["forgedTransmits"] != "reject" {
+		t.Errorf("vmmSecP = %+v, want forgedTransmits=reject", secp)
+	}
+}
+
+func TestTenantEPGFromExternalName(t *testing.T) {
+	spec := v1alpha1.TenantEPGParameters{Name: "web", Tenant: "tn1", AppProfile: "ap1", Bd: "bd1"}
+
+	cases := map[string]struct {
+		externalName string
+		want         v1alpha1.TenantEPGParameters
+		wantErr      bool
+	}{
+		"Empty": {
+			externalName: "",
+			want:         spec,
+		},
+		"DN": {
+			externalName: "uni/tn-prod/ap-shop/epg-frontend",
+			want:         v1alpha1.TenantEPGParameters{Name: "frontend", Tenant: "prod", AppProfile: "shop", Bd: "bd1"},
+		},
+		"RN": {
+			externalName: "epg-frontend",
+			want:         v1alpha1.TenantEPGParameters{Name: "frontend", Tenant: "tn1", AppProfile: "ap1", Bd: "bd1"},
+		},
+		"PlainName": {
+			externalName: "frontend",
+			wantErr:      true,
+		},
+		"BridgeDomainDN": {
+			externalName: "uni/tn-prod/BD-bd1",
+			wantErr:      true,
+		},
+		"DNWithExtraLevel": {
+			externalName: "uni/tn-prod/ap-shop/epg-frontend/rsbd",
+			wantErr:      true,
+		},
+		"RNWithPath": {
+			externalName: "epg-frontend/rsbd",
+			wantErr:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := TenantEPGFromExternalName(tc.externalName, spec)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("TenantEPGFromExternalName() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Name != tc.want.Name || got.Tenant != tc.want.Tenant || got.AppProfile != tc.want.AppProfile || got.Bd != tc.want.Bd {
+				t.Errorf("TenantEPGFromExternalName() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
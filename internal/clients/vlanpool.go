@@ -0,0 +1,174 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// VLANPoolClient verwaltet Operationen für VLAN-Pools (fvnsVlanInstP) in Cisco ACI
+type VLANPoolClient struct {
+	client *Client
+}
+
+// NewVLANPoolClient initialisiert einen neuen VLANPool-Client
+func NewVLANPoolClient(client *Client) *VLANPoolClient {
+	return &VLANPoolClient{
+		client: client,
+	}
+}
+
+// VLANPoolDN liefert den DN eines VLAN-Pools
+func VLANPoolDN(name, allocMode string) string {
+	return fmt.Sprintf("uni/infra/vlanns-[%s]-%s", name, allocMode)
+}
+
+// encapBlockAttributes liefert die Attribute eines fvnsEncapBlk
+func encapBlockAttributes(blk v1alpha1.VLANEncapBlock) map[string]string {
+	return withAttributes(map[string]string{
+		"from": fmt.Sprintf("vlan-%d", blk.From),
+		"to":   fmt.Sprintf("vlan-%d", blk.To),
+	}, map[string]string{
+		"allocMode": blk.AllocMode,
+		"role":      blk.Role,
+	})
+}
+
+// ValidateVLANPool prüft die Bereiche eines VLAN-Pools, bevor sie an den APIC geschickt werden
+func ValidateVLANPool(p v1alpha1.VLANPoolParameters) error {
+	for _, blk := range p.EncapBlocks {
+		if blk.From > blk.To {
+			return fmt.Errorf("ungültiger VLAN-Bereich %d-%d: from ist größer als to", blk.From, blk.To)
+		}
+	}
+	return nil
+}
+
+// vlanPoolPayload baut die fvnsVlanInstP-Payload auf. observed ist der aktuelle Pool oder nil;
+// nicht mehr gewünschte Bereiche werden gelöscht.
+func vlanPoolPayload(p v1alpha1.VLANPoolParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, blk := range p.EncapBlocks {
+		attrs := encapBlockAttributes(blk)
+		wanted[attrs["from"]+"/"+attrs["to"]] = true
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"fvnsEncapBlk": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	// Ein Bereich wird über from und to adressiert, deletedChildren reicht hier nicht aus
+	for _, mo := range observed.ChildrenOf("fvnsEncapBlk") {
+		if wanted[mo.Attributes["from"]+"/"+mo.Attributes["to"]] {
+			continue
+		}
+		children = append(children, map[string]interface{}{
+			"fvnsEncapBlk": map[string]interface{}{
+				"attributes": map[string]string{
+					"from":   mo.Attributes["from"],
+					"to":     mo.Attributes["to"],
+					"status": "deleted",
+				},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"fvnsVlanInstP": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":        VLANPoolDN(p.Name, p.AllocMode),
+				"name":      p.Name,
+				"allocMode": p.AllocMode,
+				"descr":     p.Desc,
+				"status":    status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateVLANPool erstellt einen neuen VLAN-Pool in Cisco ACI
+func (c *VLANPoolClient) CreateVLANPool(p v1alpha1.VLANPoolParameters) error {
+	if err := ValidateVLANPool(p); err != nil {
+		return err
+	}
+	if err := c.client.PostMO(VLANPoolDN(p.Name, p.AllocMode), vlanPoolPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen des VLAN-Pools: %v", err)
+	}
+
+	log.Println("VLAN-Pool erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateVLANPool aktualisiert einen bestehenden VLAN-Pool in Cisco ACI
+func (c *VLANPoolClient) UpdateVLANPool(p v1alpha1.VLANPoolParameters) error {
+	if err := ValidateVLANPool(p); err != nil {
+		return err
+	}
+	observed, err := c.ObserveVLANPool(p.Name, p.AllocMode)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(VLANPoolDN(p.Name, p.AllocMode), vlanPoolPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren des VLAN-Pools: %v", err)
+	}
+
+	log.Println("VLAN-Pool erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteVLANPool löscht einen bestehenden VLAN-Pool in Cisco ACI
+func (c *VLANPoolClient) DeleteVLANPool(name, allocMode string) error {
+	data := map[string]interface{}{
+		"fvnsVlanInstP": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(VLANPoolDN(name, allocMode), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen des VLAN-Pools: %v", err)
+	}
+
+	log.Println("VLAN-Pool erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveVLANPool liest einen VLAN-Pool samt Bereichen aus Cisco ACI. Gibt nil zurück, wenn er nicht existiert.
+func (c *VLANPoolClient) ObserveVLANPool(name, allocMode string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(VLANPoolDN(name, allocMode), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten des VLAN-Pools: %w", err)
+	}
+	return mo, nil
+}
+
+// VLANPoolUpToDate prüft, ob der beobachtete VLAN-Pool der Spezifikation entspricht
+func VLANPoolUpToDate(mo *ManagedObject, p v1alpha1.VLANPoolParameters) bool {
+	if mo.Attributes["descr"] != p.Desc {
+		return false
+	}
+	blocks := mo.ChildrenOf("fvnsEncapBlk")
+	if len(blocks) != len(p.EncapBlocks) {
+		return false
+	}
+	for _, blk := range p.EncapBlocks {
+		desired := encapBlockAttributes(blk)
+		found := false
+		for _, observed := range blocks {
+			if observed.Attributes["from"] == desired["from"] && observed.Attributes["to"] == desired["to"] {
+				found = attributesMatch(observed.Attributes, desired)
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
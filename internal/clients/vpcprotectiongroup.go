@@ -0,0 +1,162 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// VPCProtectionGroupClient verwaltet Operationen für vPC-Protection-Groups (fabricExplicitGEp) in Cisco ACI
+type VPCProtectionGroupClient struct {
+	client *Client
+}
+
+// NewVPCProtectionGroupClient initialisiert einen neuen VPCProtectionGroup-Client
+func NewVPCProtectionGroupClient(client *Client) *VPCProtectionGroupClient {
+	return &VPCProtectionGroupClient{
+		client: client,
+	}
+}
+
+// VPCProtectionGroupDN liefert den DN einer vPC-Protection-Group
+func VPCProtectionGroupDN(name string) string {
+	return fmt.Sprintf("uni/fabric/protpol/expgep-%s", name)
+}
+
+// ValidateVPCProtectionGroup prüft, dass genau zwei verschiedene Node-IDs angegeben sind
+func ValidateVPCProtectionGroup(p v1alpha1.VPCProtectionGroupParameters) error {
+	if len(p.NodeIDs) != 2 {
+		return fmt.Errorf("eine vPC-Protection-Group benötigt genau zwei Node-IDs, angegeben: %d", len(p.NodeIDs))
+	}
+	if p.NodeIDs[0] == p.NodeIDs[1] {
+		return fmt.Errorf("die Node-IDs einer vPC-Protection-Group müssen verschieden sein: %d", p.NodeIDs[0])
+	}
+	return nil
+}
+
+// nodeEndpointAttributes liefert die Attribute eines fabricNodePEp
+func nodeEndpointAttributes(id int, podID string) map[string]string {
+	return withAttributes(map[string]string{
+		"id": strconv.Itoa(id),
+	}, map[string]string{
+		"podId": podID,
+	})
+}
+
+// vpcProtectionGroupPayload baut die fabricExplicitGEp-Payload auf. observed ist die aktuelle Gruppe oder nil;
+// nicht mehr gewünschte Nodes werden entfernt.
+func vpcProtectionGroupPayload(p v1alpha1.VPCProtectionGroupParameters, status string, observed *ManagedObject) map[string]interface{} {
+	children := []interface{}{}
+	wanted := map[string]bool{}
+	for _, id := range p.NodeIDs {
+		attrs := nodeEndpointAttributes(id, p.PodID)
+		wanted[attrs["id"]] = true
+		attrs["status"] = "created,modified"
+		children = append(children, map[string]interface{}{
+			"fabricNodePEp": map[string]interface{}{
+				"attributes": attrs,
+			},
+		})
+	}
+	children = append(children, deletedChildren("fabricNodePEp", "id", wanted, observed.ChildrenOf("fabricNodePEp"))...)
+	children = append(children, map[string]interface{}{
+		"fabricRsVpcInstPol": map[string]interface{}{
+			"attributes": map[string]string{
+				"tnVpcInstPolName": p.VPCDomainPolicy,
+				"status":           "created,modified",
+			},
+		},
+	})
+
+	return map[string]interface{}{
+		"fabricExplicitGEp": map[string]interface{}{
+			"attributes": map[string]string{
+				"dn":     VPCProtectionGroupDN(p.Name),
+				"name":   p.Name,
+				"id":     strconv.Itoa(p.GroupID),
+				"status": status,
+			},
+			"children": children,
+		},
+	}
+}
+
+// CreateVPCProtectionGroup erstellt eine neue vPC-Protection-Group in Cisco ACI
+func (c *VPCProtectionGroupClient) CreateVPCProtectionGroup(p v1alpha1.VPCProtectionGroupParameters) error {
+	if err := ValidateVPCProtectionGroup(p); err != nil {
+		return err
+	}
+	if err := c.client.PostMO(VPCProtectionGroupDN(p.Name), vpcProtectionGroupPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der vPC-Protection-Group: %v", err)
+	}
+
+	log.Println("vPC-Protection-Group erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateVPCProtectionGroup aktualisiert eine bestehende vPC-Protection-Group in Cisco ACI
+func (c *VPCProtectionGroupClient) UpdateVPCProtectionGroup(p v1alpha1.VPCProtectionGroupParameters) error {
+	if err := ValidateVPCProtectionGroup(p); err != nil {
+		return err
+	}
+	observed, err := c.ObserveVPCProtectionGroup(p.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.PostMO(VPCProtectionGroupDN(p.Name), vpcProtectionGroupPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der vPC-Protection-Group: %v", err)
+	}
+
+	log.Println("vPC-Protection-Group erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteVPCProtectionGroup löscht eine bestehende vPC-Protection-Group in Cisco ACI
+func (c *VPCProtectionGroupClient) DeleteVPCProtectionGroup(name string) error {
+	data := map[string]interface{}{
+		"fabricExplicitGEp": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(VPCProtectionGroupDN(name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der vPC-Protection-Group: %v", err)
+	}
+
+	log.Println("vPC-Protection-Group erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveVPCProtectionGroup liest eine vPC-Protection-Group samt Nodes aus Cisco ACI.
+// Gibt nil zurück, wenn sie nicht existiert.
+func (c *VPCProtectionGroupClient) ObserveVPCProtectionGroup(name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(VPCProtectionGroupDN(name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der vPC-Protection-Group: %w", err)
+	}
+	return mo, nil
+}
+
+// VPCProtectionGroupUpToDate prüft, ob die beobachtete vPC-Protection-Group der Spezifikation entspricht
+func VPCProtectionGroupUpToDate(mo *ManagedObject, p v1alpha1.VPCProtectionGroupParameters) bool {
+	if mo.Attributes["id"] != strconv.Itoa(p.GroupID) || len(mo.ChildrenOf("fabricNodePEp")) != len(p.NodeIDs) {
+		return false
+	}
+	for _, id := range p.NodeIDs {
+		desired := nodeEndpointAttributes(id, p.PodID)
+		if ep := mo.ChildBy("fabricNodePEp", "id", desired["id"]); ep == nil || !attributesMatch(ep.Attributes, desired) {
+			return false
+		}
+	}
+	// Ohne vpcDomainPolicy verweist die Relation mit leerem Namen auf die Default-Policy
+	current := ""
+	if rs := mo.Child("fabricRsVpcInstPol"); rs != nil {
+		current = rs.Attributes["tnVpcInstPolName"]
+	}
+	return current == p.VPCDomainPolicy
+}
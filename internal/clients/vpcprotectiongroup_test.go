@@ -0,0 +1,70 @@
+package clients
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestValidateVPCProtectionGroup(t *testing.T) {
+	cases := map[string]struct {
+		nodeIDs []int
+		wantErr bool
+	}{
+		"Pair":      {nodeIDs: []int{101, 102}},
+		"NoNodes":   {nodeIDs: nil, wantErr: true},
+		"OneNode":   {nodeIDs: []int{101}, wantErr: true},
+		"ThreeNode": {nodeIDs: []int{101, 102, 103}, wantErr: true},
+		"SameNode":  {nodeIDs: []int{101, 101}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.VPCProtectionGroupParameters{Name: "vpc-101-102", GroupID: 1, NodeIDs: tc.nodeIDs}
+			err := ValidateVPCProtectionGroup(p)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ValidateVPCProtectionGroup() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestVPCProtectionGroupUpToDate(t *testing.T) {
+	observed := func(policy string) *ManagedObject {
+		return &ManagedObject{
+			Class:      "fabricExplicitGEp",
+			Attributes: map[string]string{"name": "vpc-101-102", "id": "1"},
+			Children: []ManagedObject{
+				{Class: "fabricNodePEp", Attributes: map[string]string{"id": "101"}},
+				{Class: "fabricNodePEp", Attributes: map[string]string{"id": "102"}},
+				{Class: "fabricRsVpcInstPol", Attributes: map[string]string{"tnVpcInstPolName": policy}},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		mo     *ManagedObject
+		policy string
+		want   bool
+	}{
+		"DefaultPolicy":         {mo: observed(""), policy: "", want: true},
+		"SamePolicy":            {mo: observed("vpc-pol"), policy: "vpc-pol", want: true},
+		"PolicyChanged":         {mo: observed("vpc-pol"), policy: "other", want: false},
+		"PolicyRemovedFromSpec": {mo: observed("vpc-pol"), policy: "", want: false},
+		"PolicyAdded":           {mo: observed(""), policy: "vpc-pol", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.VPCProtectionGroupParameters{
+				Name:            "vpc-101-102",
+				GroupID:         1,
+				NodeIDs:         []int{101, 102},
+				VPCDomainPolicy: tc.policy,
+			}
+			if got := VPCProtectionGroupUpToDate(tc.mo, p); got != tc.want {
+				t.Errorf("VPCProtectionGroupUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+// VRFClient verwaltet Operationen für VRFs (fvCtx) in Cisco ACI
+type VRFClient struct {
+	client *Client
+}
+
+// NewVRFClient initialisiert einen neuen VRF-Client
+func NewVRFClient(client *Client) *VRFClient {
+	return &VRFClient{
+		client: client,
+	}
+}
+
+// VRFDN liefert den DN einer VRF
+func VRFDN(tenant, name string) string {
+	return fmt.Sprintf("uni/tn-%s/ctx-%s", tenant, name)
+}
+
+// vrfAttributes liefert die konfigurierbaren fvCtx-Attribute; leere optionale Werte werden weggelassen
+func vrfAttributes(p v1alpha1.VRFParameters) map[string]string {
+	return withAttributes(map[string]string{
+		"name":  p.Name,
+		"descr": p.Desc,
+	}, map[string]string{
+		"pcEnfPref":           p.PcEnfPref,
+		"pcEnfDir":            p.PcEnfDir,
+		"ipDataPlaneLearning": p.IPDataPlaneLearning,
+		"bdEnforcedEnable":    p.BdEnforcedEnable,
+	})
+}
+
+// vrfPayload baut die fvCtx-Payload inklusive der Timer-Relationen auf. observed enthält die aktuellen
+// Kindobjekte, damit aus der Spezifikation entfernte Relationen gelöscht werden.
+func vrfPayload(p v1alpha1.VRFParameters, status string, observed []ManagedObject) map[string]interface{} {
+	attrs := vrfAttributes(p)
+	attrs["dn"] = VRFDN(p.Tenant, p.Name)
+	attrs["status"] = status
+
+	children := relationChildren("fvRsBgpCtxPol", "tnBgpCtxPolName", optionalList(p.BgpTimersPolicy), observed)
+	children = append(children, relationChildren("fvRsOspfCtxPol", "tnOspfCtxPolName", optionalList(p.OspfTimersPolicy), observed)...)
+
+	return map[string]interface{}{
+		"fvCtx": map[string]interface{}{
+			"attributes": attrs,
+			"children":   children,
+		},
+	}
+}
+
+// CreateVRF erstellt eine neue VRF in Cisco ACI
+func (c *VRFClient) CreateVRF(p v1alpha1.VRFParameters) error {
+	if err := c.client.PostMO(VRFDN(p.Tenant, p.Name), vrfPayload(p, "created", nil)); err != nil {
+		return fmt.Errorf("Fehler beim Erstellen der VRF: %v", err)
+	}
+
+	log.Println("VRF erfolgreich erstellt!")
+	return nil
+}
+
+// UpdateVRF aktualisiert eine bestehende VRF in Cisco ACI
+func (c *VRFClient) UpdateVRF(p v1alpha1.VRFParameters) error {
+	current, err := c.ObserveVRF(p.Tenant, p.Name)
+	if err != nil {
+		return err
+	}
+	var observed []ManagedObject
+	if current != nil {
+		observed = current.Children
+	}
+
+	if err := c.client.PostMO(VRFDN(p.Tenant, p.Name), vrfPayload(p, "modified", observed)); err != nil {
+		return fmt.Errorf("Fehler beim Aktualisieren der VRF: %v", err)
+	}
+
+	log.Println("VRF erfolgreich aktualisiert!")
+	return nil
+}
+
+// DeleteVRF löscht eine bestehende VRF in Cisco ACI
+func (c *VRFClient) DeleteVRF(tenant, name string) error {
+	data := map[string]interface{}{
+		"fvCtx": map[string]interface{}{
+			"attributes": map[string]string{
+				"status": "deleted",
+			},
+		},
+	}
+
+	if err := c.client.PostMO(VRFDN(tenant, name), data); err != nil {
+		return fmt.Errorf("Fehler beim Löschen der VRF: %v", err)
+	}
+
+	log.Println("VRF erfolgreich gelöscht!")
+	return nil
+}
+
+// ObserveVRF liest eine VRF samt Relationen aus Cisco ACI. Gibt nil zurück, wenn sie nicht existiert.
+func (c *VRFClient) ObserveVRF(tenant, name string) (*ManagedObject, error) {
+	mo, err := c.client.GetMO(VRFDN(tenant, name), "rsp-subtree=children")
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Beobachten der VRF: %w", err)
+	}
+	return mo, nil
+}
+
+// VRFUpToDate prüft, ob die beobachtete VRF der Spezifikation entspricht
+func VRFUpToDate(mo *ManagedObject, p v1alpha1.VRFParameters) bool {
+	if !attributesMatch(mo.Attributes, vrfAttributes(p)) {
+		return false
+	}
+	return relationsMatch("fvRsBgpCtxPol", "tnBgpCtxPolName", optionalList(p.BgpTimersPolicy), mo.Children) &&
+		relationsMatch("fvRsOspfCtxPol", "tnOspfCtxPolName", optionalList(p.OspfTimersPolicy), mo.Children)
+}
@@ -0,0 +1,105 @@
+package clients
+
+import (
+	"reflect"
+	"testing"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+)
+
+func TestVRFPayloadRelations(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.VRFParameters
+		observed []ManagedObject
+		want     []interface{}
+	}{
+		"NoRelations": {
+			p:    v1alpha1.VRFParameters{Name: "vrf1", Tenant: "tn1"},
+			want: []interface{}{},
+		},
+		"SetRelation": {
+			p: v1alpha1.VRFParameters{Name: "vrf1", Tenant: "tn1", BgpTimersPolicy: "bgp1"},
+			want: []interface{}{
+				map[string]interface{}{"fvRsBgpCtxPol": map[string]interface{}{
+					"attributes": map[string]string{"tnBgpCtxPolName": "bgp1", "status": "created,modified"},
+				}},
+			},
+		},
+		"RemovedRelation": {
+			p: v1alpha1.VRFParameters{Name: "vrf1", Tenant: "tn1"},
+			observed: []ManagedObject{
+				{Class: "fvRsBgpCtxPol", Attributes: map[string]string{"tnBgpCtxPolName": "bgp1"}},
+			},
+			want: []interface{}{
+				map[string]interface{}{"fvRsBgpCtxPol": map[string]interface{}{
+					"attributes": map[string]string{"tnBgpCtxPolName": "bgp1", "status": "deleted"},
+				}},
+			},
+		},
+		"DefaultRelationKept": {
+			p: v1alpha1.VRFParameters{Name: "vrf1", Tenant: "tn1"},
+			observed: []ManagedObject{
+				{Class: "fvRsOspfCtxPol", Attributes: map[string]string{"tnOspfCtxPolName": ""}},
+			},
+			want: []interface{}{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			payload := vrfPayload(tc.p, "modified", tc.observed)
+			got := payload["fvCtx"].(map[string]interface{})["children"]
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("vrfPayload() children = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestVRFUpToDateRelations(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.VRFParameters
+		children []ManagedObject
+		want     bool
+	}{
+		"NoRelations": {
+			p:    v1alpha1.VRFParameters{Name: "vrf1"},
+			want: true,
+		},
+		"DefaultRelations": {
+			p: v1alpha1.VRFParameters{Name: "vrf1"},
+			children: []ManagedObject{
+				{Class: "fvRsBgpCtxPol", Attributes: map[string]string{"tnBgpCtxPolName": ""}},
+				{Class: "fvRsOspfCtxPol", Attributes: map[string]string{"tnOspfCtxPolName": ""}},
+			},
+			want: true,
+		},
+		"RelationRemovedFromSpec": {
+			p: v1alpha1.VRFParameters{Name: "vrf1"},
+			children: []ManagedObject{
+				{Class: "fvRsBgpCtxPol", Attributes: map[string]string{"tnBgpCtxPolName": "bgp1"}},
+			},
+			want: false,
+		},
+		"RelationChanged": {
+			p: v1alpha1.VRFParameters{Name: "vrf1", OspfTimersPolicy: "ospf2"},
+			children: []ManagedObject{
+				{Class: "fvRsOspfCtxPol", Attributes: map[string]string{"tnOspfCtxPolName": "ospf1"}},
+			},
+			want: false,
+		},
+		"RelationMissing": {
+			p:    v1alpha1.VRFParameters{Name: "vrf1", OspfTimersPolicy: "ospf1"},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mo := &ManagedObject{Class: "fvCtx", Attributes: map[string]string{"name": "vrf1"}, Children: tc.children}
+			if got := VRFUpToDate(mo, tc.p); got != tc.want {
+				t.Errorf("VRFUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
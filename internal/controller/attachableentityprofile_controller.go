@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupAttachableEntityProfileController richtet den AttachableEntityProfile-Controller mit dem Manager ein.
+func SetupAttachableEntityProfileController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.AttachableEntityProfileGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.AttachableEntityProfile{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AttachableEntityProfileGroupVersionKind),
+			managed.WithExternalConnecter(&attachableEntityProfileConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create AttachableEntityProfile controller")
+	}
+
+	return nil
+}
+
+type attachableEntityProfileConnector struct {
+	connector
+}
+
+func (c *attachableEntityProfileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AttachableEntityProfile)
+	if !ok {
+		return nil, errors.New("managed resource is not a AttachableEntityProfile custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachableEntityProfileExternal{client: clients.NewAttachableEntityProfileClient(apiClient)}, nil
+}
+
+type attachableEntityProfileExternal struct {
+	client *clients.AttachableEntityProfileClient
+}
+
+func (c *attachableEntityProfileExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AttachableEntityProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a AttachableEntityProfile")
+	}
+
+	mo, err := c.client.ObserveAttachableEntityProfile(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.AttachableEntityProfileUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *attachableEntityProfileExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AttachableEntityProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a AttachableEntityProfile")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateAttachableEntityProfile(cr.Spec.ForProvider)
+}
+
+func (c *attachableEntityProfileExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AttachableEntityProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a AttachableEntityProfile")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateAttachableEntityProfile(cr.Spec.ForProvider)
+}
+
+func (c *attachableEntityProfileExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.AttachableEntityProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a AttachableEntityProfile")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteAttachableEntityProfile(cr.Spec.ForProvider.Name)
+}
+
+func (c *attachableEntityProfileExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
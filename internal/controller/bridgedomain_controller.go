@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupBridgeDomainController richtet den BridgeDomain-Controller mit dem Manager ein.
+func SetupBridgeDomainController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.BridgeDomainGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.BridgeDomain{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BridgeDomainGroupVersionKind),
+			managed.WithExternalConnecter(&bridgeDomainConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create BridgeDomain controller")
+	}
+
+	return nil
+}
+
+type bridgeDomainConnector struct {
+	connector
+}
+
+func (c *bridgeDomainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomain)
+	if !ok {
+		return nil, errors.New("managed resource is not a BridgeDomain custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bridgeDomainExternal{client: clients.NewBridgeDomainClient(apiClient)}, nil
+}
+
+type bridgeDomainExternal struct {
+	client *clients.BridgeDomainClient
+}
+
+func (c *bridgeDomainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a BridgeDomain")
+	}
+
+	mo, err := c.client.ObserveBridgeDomain(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.BridgeDomainUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *bridgeDomainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a BridgeDomain")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateBridgeDomain(cr.Spec.ForProvider)
+}
+
+func (c *bridgeDomainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a BridgeDomain")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateBridgeDomain(cr.Spec.ForProvider)
+}
+
+func (c *bridgeDomainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomain)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a BridgeDomain")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteBridgeDomain(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+}
+
+func (c *bridgeDomainExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupBridgeDomainSubnetController richtet den BridgeDomainSubnet-Controller mit dem Manager ein.
+func SetupBridgeDomainSubnetController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.BridgeDomainSubnetGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.BridgeDomainSubnet{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BridgeDomainSubnetGroupVersionKind),
+			managed.WithExternalConnecter(&bridgeDomainSubnetConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create BridgeDomainSubnet controller")
+	}
+
+	return nil
+}
+
+type bridgeDomainSubnetConnector struct {
+	connector
+}
+
+func (c *bridgeDomainSubnetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomainSubnet)
+	if !ok {
+		return nil, errors.New("managed resource is not a BridgeDomainSubnet custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bridgeDomainSubnetExternal{client: clients.NewBridgeDomainSubnetClient(apiClient)}, nil
+}
+
+type bridgeDomainSubnetExternal struct {
+	client *clients.BridgeDomainSubnetClient
+}
+
+func (c *bridgeDomainSubnetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomainSubnet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a BridgeDomainSubnet")
+	}
+
+	mo, err := c.client.ObserveBridgeDomainSubnet(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Bd, cr.Spec.ForProvider.IP)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.BridgeDomainSubnetUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *bridgeDomainSubnetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomainSubnet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a BridgeDomainSubnet")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateBridgeDomainSubnet(cr.Spec.ForProvider)
+}
+
+func (c *bridgeDomainSubnetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomainSubnet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a BridgeDomainSubnet")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateBridgeDomainSubnet(cr.Spec.ForProvider)
+}
+
+func (c *bridgeDomainSubnetExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.BridgeDomainSubnet)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a BridgeDomainSubnet")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteBridgeDomainSubnet(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Bd, cr.Spec.ForProvider.IP)
+}
+
+func (c *bridgeDomainSubnetExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
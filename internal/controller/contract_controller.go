@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupContractController richtet den Contract-Controller mit dem Manager ein.
+func SetupContractController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.ContractGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Contract{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ContractGroupVersionKind),
+			managed.WithExternalConnecter(&contractConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create Contract controller")
+	}
+
+	return nil
+}
+
+type contractConnector struct {
+	connector
+}
+
+func (c *contractConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Contract)
+	if !ok {
+		return nil, errors.New("managed resource is not a Contract custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractExternal{client: clients.NewContractClient(apiClient)}, nil
+}
+
+type contractExternal struct {
+	client *clients.ContractClient
+}
+
+func (c *contractExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Contract)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a Contract")
+	}
+
+	mo, err := c.client.ObserveContract(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.ContractUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *contractExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Contract)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a Contract")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateContract(cr.Spec.ForProvider)
+}
+
+func (c *contractExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Contract)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a Contract")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateContract(cr.Spec.ForProvider)
+}
+
+func (c *contractExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Contract)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a Contract")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteContract(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+}
+
+func (c *contractExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
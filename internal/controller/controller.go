@@ -0,0 +1,43 @@
+package controller
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Setup richtet alle Controller des Providers mit dem Manager ein.
+func Setup(mgr ctrl.Manager, o Options) error {
+	for _, setup := range []func(ctrl.Manager, Options) error{
+		SetupTenantEPGController,
+		SetupTenantController,
+		SetupVRFController,
+		SetupBridgeDomainController,
+		SetupBridgeDomainSubnetController,
+		SetupApplicationProfileController,
+		SetupContractController,
+		SetupFilterController,
+		SetupFilterEntryController,
+		SetupL3OutController,
+		SetupExternalEPGController,
+		SetupEndpointSecurityGroupController,
+		SetupMicroSegEPGController,
+		SetupVLANPoolController,
+		SetupPhysicalDomainController,
+		SetupAttachableEntityProfileController,
+		SetupLeafInterfacePolicyGroupController,
+		SetupLinkLevelPolicyController,
+		SetupCDPPolicyController,
+		SetupLLDPPolicyController,
+		SetupLACPPolicyController,
+		SetupMCPPolicyController,
+		SetupStormControlPolicyController,
+		SetupLeafSwitchProfileController,
+		SetupLeafInterfaceProfileController,
+		SetupVPCProtectionGroupController,
+		SetupFabricNodeController,
+	} {
+		if err := setup(mgr, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
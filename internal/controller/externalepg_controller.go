@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupExternalEPGController richtet den ExternalEPG-Controller mit dem Manager ein.
+func SetupExternalEPGController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.ExternalEPGGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ExternalEPG{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ExternalEPGGroupVersionKind),
+			managed.WithExternalConnecter(&externalEPGConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create ExternalEPG controller")
+	}
+
+	return nil
+}
+
+type externalEPGConnector struct {
+	connector
+}
+
+func (c *externalEPGConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ExternalEPG)
+	if !ok {
+		return nil, errors.New("managed resource is not a ExternalEPG custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &externalEPGExternal{client: clients.NewExternalEPGClient(apiClient)}, nil
+}
+
+type externalEPGExternal struct {
+	client *clients.ExternalEPGClient
+}
+
+func (c *externalEPGExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ExternalEPG)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a ExternalEPG")
+	}
+
+	mo, err := c.client.ObserveExternalEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.L3Out, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.ExternalEPGUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *externalEPGExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ExternalEPG)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a ExternalEPG")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateExternalEPG(cr.Spec.ForProvider)
+}
+
+func (c *externalEPGExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ExternalEPG)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a ExternalEPG")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateExternalEPG(cr.Spec.ForProvider)
+}
+
+func (c *externalEPGExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.ExternalEPG)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a ExternalEPG")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteExternalEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.L3Out, cr.Spec.ForProvider.Name)
+}
+
+func (c *externalEPGExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupFabricNodeController richtet den FabricNode-Controller mit dem Manager ein.
+func SetupFabricNodeController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.FabricNodeGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.FabricNode{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FabricNodeGroupVersionKind),
+			managed.WithExternalConnecter(&fabricNodeConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create FabricNode controller")
+	}
+
+	return nil
+}
+
+type fabricNodeConnector struct {
+	connector
+}
+
+func (c *fabricNodeConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FabricNode)
+	if !ok {
+		return nil, errors.New("managed resource is not a FabricNode custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fabricNodeExternal{client: clients.NewFabricNodeClient(apiClient)}, nil
+}
+
+type fabricNodeExternal struct {
+	client *clients.FabricNodeClient
+}
+
+func (c *fabricNodeExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FabricNode)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a FabricNode")
+	}
+
+	mo, err := c.client.ObserveFabricNode(cr.Spec.ForProvider.SerialNumber)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := c.client.ObserveFabricNodeState(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = obs
+
+	// Der Node ist erst verfügbar, wenn er in der Fabric aktiv ist
+	if obs.FabricState == "active" {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.FabricNodeUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *fabricNodeExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FabricNode)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a FabricNode")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateFabricNode(cr.Spec.ForProvider)
+}
+
+func (c *fabricNodeExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FabricNode)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a FabricNode")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateFabricNode(cr.Spec.ForProvider)
+}
+
+func (c *fabricNodeExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.FabricNode)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a FabricNode")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteFabricNode(cr.Spec.ForProvider.SerialNumber)
+}
+
+func (c *fabricNodeExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
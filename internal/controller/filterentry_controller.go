@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupFilterEntryController richtet den FilterEntry-Controller mit dem Manager ein.
+func SetupFilterEntryController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.FilterEntryGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.FilterEntry{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FilterEntryGroupVersionKind),
+			managed.WithExternalConnecter(&filterEntryConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create FilterEntry controller")
+	}
+
+	return nil
+}
+
+type filterEntryConnector struct {
+	connector
+}
+
+func (c *filterEntryConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.FilterEntry)
+	if !ok {
+		return nil, errors.New("managed resource is not a FilterEntry custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &filterEntryExternal{client: clients.NewFilterEntryClient(apiClient)}, nil
+}
+
+type filterEntryExternal struct {
+	client *clients.FilterEntryClient
+}
+
+func (c *filterEntryExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FilterEntry)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a FilterEntry")
+	}
+
+	mo, err := c.client.ObserveFilterEntry(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Filter, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.FilterEntryUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *filterEntryExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FilterEntry)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a FilterEntry")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateFilterEntry(cr.Spec.ForProvider)
+}
+
+func (c *filterEntryExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FilterEntry)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a FilterEntry")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateFilterEntry(cr.Spec.ForProvider)
+}
+
+func (c *filterEntryExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.FilterEntry)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a FilterEntry")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteFilterEntry(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Filter, cr.Spec.ForProvider.Name)
+}
+
+func (c *filterEntryExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupL3OutController richtet den L3Out-Controller mit dem Manager ein.
+func SetupL3OutController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.L3OutGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.L3Out{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.L3OutGroupVersionKind),
+			managed.WithExternalConnecter(&l3OutConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create L3Out controller")
+	}
+
+	return nil
+}
+
+type l3OutConnector struct {
+	connector
+}
+
+func (c *l3OutConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.L3Out)
+	if !ok {
+		return nil, errors.New("managed resource is not a L3Out custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l3OutExternal{client: clients.NewL3OutClient(apiClient)}, nil
+}
+
+type l3OutExternal struct {
+	client *clients.L3OutClient
+}
+
+func (c *l3OutExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.L3Out)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a L3Out")
+	}
+
+	mo, err := c.client.ObserveL3Out(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.L3OutUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *l3OutExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.L3Out)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a L3Out")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateL3Out(cr.Spec.ForProvider)
+}
+
+func (c *l3OutExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.L3Out)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a L3Out")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateL3Out(cr.Spec.ForProvider)
+}
+
+func (c *l3OutExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.L3Out)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a L3Out")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteL3Out(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+}
+
+func (c *l3OutExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
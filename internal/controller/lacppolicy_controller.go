@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLACPPolicyController richtet den LACPPolicy-Controller mit dem Manager ein.
+func SetupLACPPolicyController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LACPPolicyGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LACPPolicy{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LACPPolicyGroupVersionKind),
+			managed.WithExternalConnecter(&lacpPolicyConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LACPPolicy controller")
+	}
+
+	return nil
+}
+
+type lacpPolicyConnector struct {
+	connector
+}
+
+func (c *lacpPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LACPPolicy)
+	if !ok {
+		return nil, errors.New("managed resource is not a LACPPolicy custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lacpPolicyExternal{client: clients.NewLACPPolicyClient(apiClient)}, nil
+}
+
+type lacpPolicyExternal struct {
+	client *clients.LACPPolicyClient
+}
+
+func (c *lacpPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LACPPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LACPPolicy")
+	}
+
+	mo, err := c.client.ObserveLACPPolicy(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LACPPolicyUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *lacpPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LACPPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LACPPolicy")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLACPPolicy(cr.Spec.ForProvider)
+}
+
+func (c *lacpPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LACPPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LACPPolicy")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLACPPolicy(cr.Spec.ForProvider)
+}
+
+func (c *lacpPolicyExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LACPPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LACPPolicy")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLACPPolicy(cr.Spec.ForProvider.Name)
+}
+
+func (c *lacpPolicyExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLeafInterfacePolicyGroupController richtet den LeafInterfacePolicyGroup-Controller mit dem Manager ein.
+func SetupLeafInterfacePolicyGroupController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LeafInterfacePolicyGroupGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LeafInterfacePolicyGroup{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LeafInterfacePolicyGroupGroupVersionKind),
+			managed.WithExternalConnecter(&leafInterfacePolicyGroupConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LeafInterfacePolicyGroup controller")
+	}
+
+	return nil
+}
+
+type leafInterfacePolicyGroupConnector struct {
+	connector
+}
+
+func (c *leafInterfacePolicyGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfacePolicyGroup)
+	if !ok {
+		return nil, errors.New("managed resource is not a LeafInterfacePolicyGroup custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &leafInterfacePolicyGroupExternal{client: clients.NewLeafInterfacePolicyGroupClient(apiClient)}, nil
+}
+
+type leafInterfacePolicyGroupExternal struct {
+	client *clients.LeafInterfacePolicyGroupClient
+}
+
+func (c *leafInterfacePolicyGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfacePolicyGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LeafInterfacePolicyGroup")
+	}
+
+	mo, err := c.client.ObserveLeafInterfacePolicyGroup(cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Type)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LeafInterfacePolicyGroupUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *leafInterfacePolicyGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfacePolicyGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LeafInterfacePolicyGroup")
+	}
+
+	if err := c.checkPolicies(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLeafInterfacePolicyGroup(cr.Spec.ForProvider)
+}
+
+func (c *leafInterfacePolicyGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfacePolicyGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LeafInterfacePolicyGroup")
+	}
+
+	if err := c.checkPolicies(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLeafInterfacePolicyGroup(cr.Spec.ForProvider)
+}
+
+func (c *leafInterfacePolicyGroupExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfacePolicyGroup)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LeafInterfacePolicyGroup")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLeafInterfacePolicyGroup(cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Type)
+}
+
+// checkPolicies stellt sicher, dass alle referenzierten Interface-Policies existieren,
+// bevor die Policy-Gruppe an den APIC geschickt wird
+func (c *leafInterfacePolicyGroupExternal) checkPolicies(p v1alpha1.LeafInterfacePolicyGroupParameters) error {
+	missing, err := c.client.MissingLeafInterfacePolicies(p)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return errors.Errorf("referenced interface policies do not exist: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (c *leafInterfacePolicyGroupExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLeafInterfaceProfileController richtet den LeafInterfaceProfile-Controller mit dem Manager ein.
+func SetupLeafInterfaceProfileController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LeafInterfaceProfileGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LeafInterfaceProfile{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LeafInterfaceProfileGroupVersionKind),
+			managed.WithExternalConnecter(&leafInterfaceProfileConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LeafInterfaceProfile controller")
+	}
+
+	return nil
+}
+
+type leafInterfaceProfileConnector struct {
+	connector
+}
+
+func (c *leafInterfaceProfileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfaceProfile)
+	if !ok {
+		return nil, errors.New("managed resource is not a LeafInterfaceProfile custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &leafInterfaceProfileExternal{client: clients.NewLeafInterfaceProfileClient(apiClient)}, nil
+}
+
+type leafInterfaceProfileExternal struct {
+	client *clients.LeafInterfaceProfileClient
+}
+
+func (c *leafInterfaceProfileExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfaceProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LeafInterfaceProfile")
+	}
+
+	// Überschneidungen werden unabhängig davon gemeldet, ob das Profil bereits existiert. Die Ressource
+	// gilt dann als nicht synchronisiert; gelöscht werden kann sie trotzdem.
+	if err := checkSelectors(cr, clients.LeafInterfaceProfileOverlaps(cr.Spec.ForProvider)); err != nil && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, err
+	}
+
+	mo, err := c.client.ObserveLeafInterfaceProfile(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LeafInterfaceProfileUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *leafInterfaceProfileExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfaceProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LeafInterfaceProfile")
+	}
+
+	if err := checkSelectors(cr, clients.LeafInterfaceProfileOverlaps(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLeafInterfaceProfile(cr.Spec.ForProvider)
+}
+
+func (c *leafInterfaceProfileExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfaceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LeafInterfaceProfile")
+	}
+
+	if err := checkSelectors(cr, clients.LeafInterfaceProfileOverlaps(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLeafInterfaceProfile(cr.Spec.ForProvider)
+}
+
+func (c *leafInterfaceProfileExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LeafInterfaceProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LeafInterfaceProfile")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLeafInterfaceProfile(cr.Spec.ForProvider.Name)
+}
+
+func (c *leafInterfaceProfileExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLeafSwitchProfileController richtet den LeafSwitchProfile-Controller mit dem Manager ein.
+func SetupLeafSwitchProfileController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LeafSwitchProfileGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LeafSwitchProfile{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LeafSwitchProfileGroupVersionKind),
+			managed.WithExternalConnecter(&leafSwitchProfileConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LeafSwitchProfile controller")
+	}
+
+	return nil
+}
+
+type leafSwitchProfileConnector struct {
+	connector
+}
+
+func (c *leafSwitchProfileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LeafSwitchProfile)
+	if !ok {
+		return nil, errors.New("managed resource is not a LeafSwitchProfile custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &leafSwitchProfileExternal{client: clients.NewLeafSwitchProfileClient(apiClient)}, nil
+}
+
+type leafSwitchProfileExternal struct {
+	client *clients.LeafSwitchProfileClient
+}
+
+func (c *leafSwitchProfileExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LeafSwitchProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LeafSwitchProfile")
+	}
+
+	// Überschneidungen werden unabhängig davon gemeldet, ob das Profil bereits existiert. Die Ressource
+	// gilt dann als nicht synchronisiert; gelöscht werden kann sie trotzdem.
+	if err := checkSelectors(cr, clients.LeafSwitchProfileOverlaps(cr.Spec.ForProvider)); err != nil && !meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, err
+	}
+
+	mo, err := c.client.ObserveLeafSwitchProfile(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LeafSwitchProfileUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *leafSwitchProfileExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LeafSwitchProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LeafSwitchProfile")
+	}
+
+	if err := checkSelectors(cr, clients.LeafSwitchProfileOverlaps(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLeafSwitchProfile(cr.Spec.ForProvider)
+}
+
+func (c *leafSwitchProfileExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LeafSwitchProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LeafSwitchProfile")
+	}
+
+	if err := checkSelectors(cr, clients.LeafSwitchProfileOverlaps(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLeafSwitchProfile(cr.Spec.ForProvider)
+}
+
+func (c *leafSwitchProfileExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LeafSwitchProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LeafSwitchProfile")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLeafSwitchProfile(cr.Spec.ForProvider.Name)
+}
+
+// checkSelectors setzt die SelectorsValid-Condition anhand der gefundenen Überschneidungen
+// und liefert einen Fehler, wenn sich Selektoren des Profils überschneiden
+func checkSelectors(cr resource.Managed, overlaps []string) error {
+	if len(overlaps) > 0 {
+		msg := "overlapping selectors: " + strings.Join(overlaps, "; ")
+		cr.SetConditions(v1alpha1.SelectorsOverlap(msg))
+		return errors.New(msg)
+	}
+	cr.SetConditions(v1alpha1.SelectorsValid())
+	return nil
+}
+
+func (c *leafSwitchProfileExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLinkLevelPolicyController richtet den LinkLevelPolicy-Controller mit dem Manager ein.
+func SetupLinkLevelPolicyController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LinkLevelPolicyGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LinkLevelPolicy{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LinkLevelPolicyGroupVersionKind),
+			managed.WithExternalConnecter(&linkLevelPolicyConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LinkLevelPolicy controller")
+	}
+
+	return nil
+}
+
+type linkLevelPolicyConnector struct {
+	connector
+}
+
+func (c *linkLevelPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LinkLevelPolicy)
+	if !ok {
+		return nil, errors.New("managed resource is not a LinkLevelPolicy custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &linkLevelPolicyExternal{client: clients.NewLinkLevelPolicyClient(apiClient)}, nil
+}
+
+type linkLevelPolicyExternal struct {
+	client *clients.LinkLevelPolicyClient
+}
+
+func (c *linkLevelPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LinkLevelPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LinkLevelPolicy")
+	}
+
+	mo, err := c.client.ObserveLinkLevelPolicy(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LinkLevelPolicyUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *linkLevelPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LinkLevelPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LinkLevelPolicy")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLinkLevelPolicy(cr.Spec.ForProvider)
+}
+
+func (c *linkLevelPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LinkLevelPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LinkLevelPolicy")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLinkLevelPolicy(cr.Spec.ForProvider)
+}
+
+func (c *linkLevelPolicyExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LinkLevelPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LinkLevelPolicy")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLinkLevelPolicy(cr.Spec.ForProvider.Name)
+}
+
+func (c *linkLevelPolicyExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
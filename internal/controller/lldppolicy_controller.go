@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupLLDPPolicyController richtet den LLDPPolicy-Controller mit dem Manager ein.
+func SetupLLDPPolicyController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.LLDPPolicyGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LLDPPolicy{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LLDPPolicyGroupVersionKind),
+			managed.WithExternalConnecter(&lldpPolicyConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create LLDPPolicy controller")
+	}
+
+	return nil
+}
+
+type lldpPolicyConnector struct {
+	connector
+}
+
+func (c *lldpPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LLDPPolicy)
+	if !ok {
+		return nil, errors.New("managed resource is not a LLDPPolicy custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lldpPolicyExternal{client: clients.NewLLDPPolicyClient(apiClient)}, nil
+}
+
+type lldpPolicyExternal struct {
+	client *clients.LLDPPolicyClient
+}
+
+func (c *lldpPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LLDPPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a LLDPPolicy")
+	}
+
+	mo, err := c.client.ObserveLLDPPolicy(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.LLDPPolicyUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *lldpPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LLDPPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a LLDPPolicy")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateLLDPPolicy(cr.Spec.ForProvider)
+}
+
+func (c *lldpPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LLDPPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a LLDPPolicy")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateLLDPPolicy(cr.Spec.ForProvider)
+}
+
+func (c *lldpPolicyExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.LLDPPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a LLDPPolicy")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteLLDPPolicy(cr.Spec.ForProvider.Name)
+}
+
+func (c *lldpPolicyExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupPhysicalDomainController richtet den PhysicalDomain-Controller mit dem Manager ein.
+func SetupPhysicalDomainController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.PhysicalDomainGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PhysicalDomain{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PhysicalDomainGroupVersionKind),
+			managed.WithExternalConnecter(&physicalDomainConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create PhysicalDomain controller")
+	}
+
+	return nil
+}
+
+type physicalDomainConnector struct {
+	connector
+}
+
+func (c *physicalDomainConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PhysicalDomain)
+	if !ok {
+		return nil, errors.New("managed resource is not a PhysicalDomain custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &physicalDomainExternal{client: clients.NewPhysicalDomainClient(apiClient)}, nil
+}
+
+type physicalDomainExternal struct {
+	client *clients.PhysicalDomainClient
+}
+
+func (c *physicalDomainExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PhysicalDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a PhysicalDomain")
+	}
+
+	mo, err := c.client.ObservePhysicalDomain(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.PhysicalDomainUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *physicalDomainExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PhysicalDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a PhysicalDomain")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreatePhysicalDomain(cr.Spec.ForProvider)
+}
+
+func (c *physicalDomainExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PhysicalDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a PhysicalDomain")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdatePhysicalDomain(cr.Spec.ForProvider)
+}
+
+func (c *physicalDomainExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.PhysicalDomain)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a PhysicalDomain")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeletePhysicalDomain(cr.Spec.ForProvider.Name)
+}
+
+func (c *physicalDomainExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupTenantController richtet den Tenant-Controller mit dem Manager ein.
+func SetupTenantController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.TenantGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Tenant{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TenantGroupVersionKind),
+			managed.WithExternalConnecter(&tenantConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create Tenant controller")
+	}
+
+	return nil
+}
+
+type tenantConnector struct {
+	connector
+}
+
+func (c *tenantConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return nil, errors.New("managed resource is not a Tenant custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantExternal{client: clients.NewTenantClient(apiClient)}, nil
+}
+
+type tenantExternal struct {
+	client *clients.TenantClient
+}
+
+func (c *tenantExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a Tenant")
+	}
+
+	mo, err := c.client.ObserveTenant(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// Vergleiche die beobachteten Attribute mit der Spezifikation
+	p := cr.Spec.ForProvider
+	upToDate := mo.Attributes["descr"] == p.Desc &&
+		mo.Attributes["nameAlias"] == p.NameAlias &&
+		mo.Attributes["annotation"] == p.Annotation
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (c *tenantExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a Tenant")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateTenant(cr.Spec.ForProvider)
+}
+
+func (c *tenantExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a Tenant")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateTenant(cr.Spec.ForProvider)
+}
+
+func (c *tenantExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a Tenant")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteTenant(cr.Spec.ForProvider.Name)
+}
+
+func (c *tenantExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
@@ -3,17 +3,19 @@ package controller
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	// Import global API types
 	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
@@ -23,7 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-// Options definiert die Konfigurationsoptionen für den TenantEPG-Controller
+// Options definiert die Konfigurationsoptionen für die Controller des Providers
 type Options struct {
 	Logger                  logging.Logger
 	MaxConcurrentReconciles int
@@ -35,17 +37,24 @@ func SetupTenantEPGController(mgr ctrl.Manager, o Options) error {
 	name := managed.ControllerName(v1alpha1.TenantEPGGroupKind)
 
 	// Definieren des Controllers mit den gewünschten Optionen
-	_, err := ctrl.NewControllerManagedBy(mgr).
+	err := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.TenantEPG{}).
-		WithOptions(ctrl.Options{
+		WithOptions(controller.Options{
 			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		}).
 		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TenantEPGGroupVersionKind),
 			managed.WithExternalConnecter(&connector{
 				kube:        mgr.GetClient(),
 				newClientFn: clients.NewClient,
 			}),
+			// Der External Name ist der DN der EPG und wird erst nach dem Anlegen gesetzt,
+			// daher nicht mit dem Namen des Kubernetes-Objekts vorbelegen
+			managed.WithInitializers(),
+			// Tenant, Application Profile und Bridge Domain aus Referenzen auflösen
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 		))
@@ -67,15 +76,33 @@ type Credentials struct {
 	Password string `json:"password"`
 }
 
-func (c *connector) Connect(ctx context.Context, mg managed.Managed) (managed.ExternalClient, error) {
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
 	cr, ok := mg.(*v1alpha1.TenantEPG)
 	if !ok {
 		return nil, errors.New("managed resource is not a TenantEPG custom resource")
 	}
 
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{
+		client:      clients.NewTenantEPGClient(apiClient),
+		appProfiles: clients.NewApplicationProfileClient(apiClient),
+	}, nil
+}
+
+// newAPIClient erstellt einen ACI-Client anhand der ProviderConfig der Managed Resource
+func (c *connector) newAPIClient(ctx context.Context, mg resource.Managed) (*clients.Client, error) {
+	pcRef := mg.GetProviderConfigReference()
+	if pcRef == nil {
+		return nil, errors.New("managed resource does not reference a ProviderConfig")
+	}
+
 	// ProviderConfig abrufen
 	pc := &v1alpha1.ProviderConfig{}
-	if err := c.kube.Get(ctx, client.ObjectKey{Name: cr.Spec.ProviderConfigReference.Name}, pc); err != nil {
+	if err := c.kube.Get(ctx, client.ObjectKey{Name: pcRef.Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get ProviderConfig")
 	}
 
@@ -100,62 +127,108 @@ func (c *connector) Connect(ctx context.Context, mg managed.Managed) (managed.Ex
 	insecureSkipVerify := pc.Spec.InsecureSkipVerify
 
 	// Client erstellen
-	apiClient := c.newClientFn(pc.Spec.URL, creds.Username, creds.Password, insecureSkipVerify)
-
-	return &external{client: clients.NewTenantEPGClient(apiClient)}, nil
+	return c.newClientFn(pc.Spec.URL, creds.Username, creds.Password, insecureSkipVerify), nil
 }
 
 type external struct {
-	client *clients.TenantEPGClient
+	client      *clients.TenantEPGClient
+	appProfiles *clients.ApplicationProfileClient
 }
 
-func (c *external) Observe(ctx context.Context, mg managed.Managed) (managed.ExternalObservation, error) {
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mg.(*v1alpha1.TenantEPG)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New("managed resource is not a TenantEPG")
 	}
 
+	p, err := clients.TenantEPGFromExternalName(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	// ObserveTenantEPG mit tenant, appProfile, epgName aufrufen
-	exists, err := c.client.ObserveTenantEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.AppProfile, cr.Spec.ForProvider.Name)
+	mo, err := c.client.ObserveTenantEPG(p.Tenant, p.AppProfile, p.Name)
 	if err != nil {
-		// Wenn das TenantEPG nicht gefunden wird, setzen wir ResourceExists auf false
-		if !exists {
-			return managed.ExternalObservation{
-				ResourceExists: false,
-			}, nil
-		}
 		return managed.ExternalObservation{}, err
 	}
+	if mo == nil {
+		// Wenn das TenantEPG nicht gefunden wird, setzen wir ResourceExists auf false
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	// Beobachteten Zustand der EPG bei jedem Poll im Status melden
+	cr.Status.AtProvider = clients.TenantEPGAtProvider(mo)
+
+	cr.SetConditions(xpv1.Available())
+
+	// Nicht gesetzte optionale Felder aus der bestehenden EPG übernehmen, damit eine
+	// übernommene EPG ihre Einstellungen behält. p ist eine Kopie der Spezifikation und
+	// wird ebenso ergänzt, da der Vergleich unten auf p beruht.
+	lateInitialized := clients.LateInitializeTenantEPG(&cr.Spec.ForProvider, mo)
+	clients.LateInitializeTenantEPG(&p, mo)
+
+	// Bereits bestehende EPGs bekommen ihren DN als External Name
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, clients.TenantEPGDN(p.Tenant, p.AppProfile, p.Name))
+		lateInitialized = true
+	}
 
-	// Wenn epgData vorhanden ist, setzen wir ResourceExists auf true
+	// Beschreibung, Bridge Domain und Relationen mit der Spezifikation vergleichen; Name, Tenant
+	// und Application Profile kommen dabei aus dem External Name
 	return managed.ExternalObservation{
-		ResourceExists: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        clients.TenantEPGUpToDate(mo, p),
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
-func (c *external) Create(ctx context.Context, mg managed.Managed) (managed.ExternalCreation, error) {
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.TenantEPG)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New("managed resource is not a TenantEPG")
 	}
 
-	// CreateTenantEPG mit tenant, appProfile, epgName, bd, desc aufrufen
-	err := c.client.CreateTenantEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.AppProfile, cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Bd, cr.Spec.ForProvider.Desc)
+	p, err := clients.TenantEPGFromExternalName(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// Die EPG kann erst angelegt werden, wenn das Application Profile existiert
+	ap, err := c.appProfiles.ObserveApplicationProfile(p.Tenant, p.AppProfile)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if ap == nil {
+		return managed.ExternalCreation{}, errors.Errorf("application profile %s does not exist in tenant %s", p.AppProfile, p.Tenant)
+	}
+
+	// CreateTenantEPG mit den Parametern der Spezifikation aufrufen
+	err = c.client.CreateTenantEPG(p)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
+	// Der DN der angelegten EPG wird zum External Name
+	meta.SetExternalName(cr, clients.TenantEPGDN(p.Tenant, p.AppProfile, p.Name))
+
 	return managed.ExternalCreation{}, nil
 }
 
-func (c *external) Update(ctx context.Context, mg managed.Managed) (managed.ExternalUpdate, error) {
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*v1alpha1.TenantEPG)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New("managed resource is not a TenantEPG")
 	}
 
-	// UpdateTenantEPG mit tenant, appProfile, epgName, bd, desc aufrufen
-	err := c.client.UpdateTenantEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.AppProfile, cr.Spec.ForProvider.Name, cr.Spec.ForProvider.Bd, cr.Spec.ForProvider.Desc)
+	p, err := clients.TenantEPGFromExternalName(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// UpdateTenantEPG mit den Parametern der Spezifikation aufrufen
+	err = c.client.UpdateTenantEPG(p)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
@@ -163,18 +236,27 @@ func (c *external) Update(ctx context.Context, mg managed.Managed) (managed.Exte
 	return managed.ExternalUpdate{}, nil
 }
 
-func (c *external) Delete(ctx context.Context, mg managed.Managed) error {
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	cr, ok := mg.(*v1alpha1.TenantEPG)
 	if !ok {
-		return errors.New("managed resource is not a TenantEPG")
+		return managed.ExternalDelete{}, errors.New("managed resource is not a TenantEPG")
+	}
+
+	p, err := clients.TenantEPGFromExternalName(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalDelete{}, err
 	}
 
 	// DeleteTenantEPG mit tenant, appProfile, epgName aufrufen
-	err := c.client.DeleteTenantEPG(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.AppProfile, cr.Spec.ForProvider.Name)
+	err = c.client.DeleteTenantEPG(p.Tenant, p.AppProfile, p.Name)
 	if err != nil {
-		return err
+		return managed.ExternalDelete{}, err
 	}
 
+	return managed.ExternalDelete{}, nil
+}
+
+func (c *external) Disconnect(ctx context.Context) error {
 	return nil
 }
 
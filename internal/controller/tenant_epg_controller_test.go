@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+func TestTenantEPGObserveExternalName(t *testing.T) {
+	const epgDN = "uni/tn-prod/ap-shop/epg-frontend"
+
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		if r.URL.Path != fmt.Sprintf("/api/node/mo/%s.json", epgDN) {
+			fmt.Fprint(w, `{"imdata":[]}`)
+			return
+		}
+		fmt.Fprint(w, `{"imdata":[{"fvAEPg":{"attributes":{"dn":"`+epgDN+`","name":"frontend","descr":"shop frontend","prio":"level3"},"children":[
+			{"fvRsBd":{"attributes":{"tnFvBDName":"bd1"}}}]}}]}`)
+	}))
+	defer server.Close()
+
+	apiClient := clients.NewClient(server.URL, "admin", "secret", false)
+	apiClient.Token = "token"
+	e := &external{
+		client:      clients.NewTenantEPGClient(apiClient),
+		appProfiles: clients.NewApplicationProfileClient(apiClient),
+	}
+
+	// Name, Tenant und Application Profile der Spezifikation weichen vom External Name ab
+	cr := &v1alpha1.TenantEPG{}
+	cr.Spec.ForProvider = v1alpha1.TenantEPGParameters{
+		Name:       "web",
+		Desc:       "shop frontend",
+		Tenant:     "tn1",
+		AppProfile: "ap1",
+		Bd:         "bd1",
+	}
+	meta.SetExternalName(cr, epgDN)
+
+	obs, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if len(requests) != 1 || requests[0] != fmt.Sprintf("/api/node/mo/%s.json", epgDN) {
+		t.Errorf("Observe() requested %v, want only the EPG named by the external name", requests)
+	}
+	if !obs.ResourceExists {
+		t.Errorf("Observe() ResourceExists = false, want true")
+	}
+	if !obs.ResourceUpToDate {
+		t.Errorf("Observe() ResourceUpToDate = false, want true for an EPG identified by its external name")
+	}
+	if !obs.ResourceLateInitialized {
+		t.Errorf("Observe() ResourceLateInitialized = false, want true")
+	}
+	if prio := cr.Spec.ForProvider.Prio; prio == nil || *prio != "level3" {
+		t.Errorf("spec.forProvider.prio = %v, want level3", prio)
+	}
+	if cr.Spec.ForProvider.Name != "web" {
+		t.Errorf("spec.forProvider.name = %q, want it unchanged", cr.Spec.ForProvider.Name)
+	}
+}
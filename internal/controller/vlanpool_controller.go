@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupVLANPoolController richtet den VLANPool-Controller mit dem Manager ein.
+func SetupVLANPoolController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.VLANPoolGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VLANPool{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VLANPoolGroupVersionKind),
+			managed.WithExternalConnecter(&vlanPoolConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create VLANPool controller")
+	}
+
+	return nil
+}
+
+type vlanPoolConnector struct {
+	connector
+}
+
+func (c *vlanPoolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VLANPool)
+	if !ok {
+		return nil, errors.New("managed resource is not a VLANPool custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vlanPoolExternal{client: clients.NewVLANPoolClient(apiClient)}, nil
+}
+
+type vlanPoolExternal struct {
+	client *clients.VLANPoolClient
+}
+
+func (c *vlanPoolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VLANPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a VLANPool")
+	}
+
+	mo, err := c.client.ObserveVLANPool(cr.Spec.ForProvider.Name, cr.Spec.ForProvider.AllocMode)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.VLANPoolUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *vlanPoolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VLANPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a VLANPool")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateVLANPool(cr.Spec.ForProvider)
+}
+
+func (c *vlanPoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VLANPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a VLANPool")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateVLANPool(cr.Spec.ForProvider)
+}
+
+func (c *vlanPoolExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.VLANPool)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a VLANPool")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteVLANPool(cr.Spec.ForProvider.Name, cr.Spec.ForProvider.AllocMode)
+}
+
+func (c *vlanPoolExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
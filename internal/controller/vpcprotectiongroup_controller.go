@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupVPCProtectionGroupController richtet den VPCProtectionGroup-Controller mit dem Manager ein.
+func SetupVPCProtectionGroupController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.VPCProtectionGroupGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPCProtectionGroup{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPCProtectionGroupGroupVersionKind),
+			managed.WithExternalConnecter(&vpcProtectionGroupConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create VPCProtectionGroup controller")
+	}
+
+	return nil
+}
+
+type vpcProtectionGroupConnector struct {
+	connector
+}
+
+func (c *vpcProtectionGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPCProtectionGroup)
+	if !ok {
+		return nil, errors.New("managed resource is not a VPCProtectionGroup custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpcProtectionGroupExternal{client: clients.NewVPCProtectionGroupClient(apiClient)}, nil
+}
+
+type vpcProtectionGroupExternal struct {
+	client *clients.VPCProtectionGroupClient
+}
+
+func (c *vpcProtectionGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VPCProtectionGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a VPCProtectionGroup")
+	}
+
+	mo, err := c.client.ObserveVPCProtectionGroup(cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.VPCProtectionGroupUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *vpcProtectionGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VPCProtectionGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a VPCProtectionGroup")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateVPCProtectionGroup(cr.Spec.ForProvider)
+}
+
+func (c *vpcProtectionGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VPCProtectionGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a VPCProtectionGroup")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateVPCProtectionGroup(cr.Spec.ForProvider)
+}
+
+func (c *vpcProtectionGroupExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.VPCProtectionGroup)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a VPCProtectionGroup")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteVPCProtectionGroup(cr.Spec.ForProvider.Name)
+}
+
+func (c *vpcProtectionGroupExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
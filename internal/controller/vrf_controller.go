@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	// Import global API types
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
+
+	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
+)
+
+// SetupVRFController richtet den VRF-Controller mit dem Manager ein.
+func SetupVRFController(mgr ctrl.Manager, o Options) error {
+	name := managed.ControllerName(v1alpha1.VRFGroupKind)
+
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VRF{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VRFGroupVersionKind),
+			managed.WithExternalConnecter(&vrfConnector{connector{
+				kube:        mgr.GetClient(),
+				newClientFn: clients.NewClient,
+			}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+	if err != nil {
+		return errors.Wrap(err, "cannot create VRF controller")
+	}
+
+	return nil
+}
+
+type vrfConnector struct {
+	connector
+}
+
+func (c *vrfConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VRF)
+	if !ok {
+		return nil, errors.New("managed resource is not a VRF custom resource")
+	}
+
+	apiClient, err := c.newAPIClient(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vrfExternal{client: clients.NewVRFClient(apiClient)}, nil
+}
+
+type vrfExternal struct {
+	client *clients.VRFClient
+}
+
+func (c *vrfExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.VRF)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New("managed resource is not a VRF")
+	}
+
+	mo, err := c.client.ObserveVRF(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if mo == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: clients.VRFUpToDate(mo, cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *vrfExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.VRF)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New("managed resource is not a VRF")
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, c.client.CreateVRF(cr.Spec.ForProvider)
+}
+
+func (c *vrfExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.VRF)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New("managed resource is not a VRF")
+	}
+
+	return managed.ExternalUpdate{}, c.client.UpdateVRF(cr.Spec.ForProvider)
+}
+
+func (c *vrfExternal) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*v1alpha1.VRF)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New("managed resource is not a VRF")
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	return managed.ExternalDelete{}, c.client.DeleteVRF(cr.Spec.ForProvider.Tenant, cr.Spec.ForProvider.Name)
+}
+
+func (c *vrfExternal) Disconnect(ctx context.Context) error {
+	return nil
+}
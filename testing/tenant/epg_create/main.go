@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
 	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
 )
 
@@ -26,7 +27,13 @@ func main() {
 	}
 
 	epgClient := clients.NewTenantEPGClient(client)
-	err := epgClient.CreateTenantEPG(*tenant, *appProfile, *epgName, *bd, *desc)
+	err := epgClient.CreateTenantEPG(v1alpha1.TenantEPGParameters{
+		Name:       *epgName,
+		Tenant:     *tenant,
+		AppProfile: *appProfile,
+		Desc:       *desc,
+		Bd:         *bd,
+	})
 	if err != nil {
 		log.Fatalf("Failed to create EPG: %v", err)
 	}
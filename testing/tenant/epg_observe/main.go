@@ -26,13 +26,13 @@ func main() {
 	epgClient := clients.NewTenantEPGClient(client)
 
 	// Beobachten des EPG-Status
-	exists, err := epgClient.ObserveTenantEPG(*tenant, *appProfile, *epgName)
+	epg, err := epgClient.ObserveTenantEPG(*tenant, *appProfile, *epgName)
 	if err != nil {
 		log.Fatalf("Error observing EPG: %v", err)
 	}
 
-	if exists {
-		fmt.Printf("EPG %s observed successfully.\n", *epgName)
+	if epg != nil {
+		fmt.Printf("EPG %s observed successfully: %v\n", *epgName, epg.Attributes)
 	} else {
 		fmt.Printf("EPG %s does not exist.\n", *epgName)
 	}
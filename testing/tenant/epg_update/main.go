@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 
+	v1alpha1 "github.com/patrikbolt/crossplane_provider_cisco_aci/apis/v1alpha1"
 	"github.com/patrikbolt/crossplane_provider_cisco_aci/internal/clients"
 )
 
@@ -31,8 +32,31 @@ func main() {
 	// Create the EPG client
 	epgClient := clients.NewTenantEPGClient(client)
 
+	// Observe the EPG first so that its relations are kept; UpdateTenantEPG deletes
+	// every contract, static path and domain that is not part of the parameters
+	epg, err := epgClient.ObserveTenantEPG(*tenant, *appProfile, *epgName)
+	if err != nil {
+		fmt.Printf("Error observing EPG: %v\n", err)
+		return
+	}
+	if epg == nil {
+		fmt.Printf("EPG %s does not exist.\n", *epgName)
+		return
+	}
+
+	params := currentParameters(epg)
+	params.Name = *epgName
+	params.Tenant = *tenant
+	params.AppProfile = *appProfile
+	if *desc != "" {
+		params.Desc = *desc
+	}
+	if *bd != "" {
+		params.Bd = *bd
+	}
+
 	// Update the EPG with the specified parameters
-	err := epgClient.UpdateTenantEPG(*tenant, *appProfile, *epgName, *bd, *desc)
+	err = epgClient.UpdateTenantEPG(params)
 	if err != nil {
 		fmt.Printf("Error updating EPG: %v\n", err)
 	} else {
@@ -40,3 +64,43 @@ func main() {
 	}
 }
 
+// currentParameters returns the description, bridge domain and relations of an observed EPG
+func currentParameters(epg *clients.ManagedObject) v1alpha1.TenantEPGParameters {
+	params := v1alpha1.TenantEPGParameters{
+		Desc: epg.Attributes["descr"],
+	}
+	if rs := epg.Child("fvRsBd"); rs != nil {
+		params.Bd = rs.Attributes["tnFvBDName"]
+	}
+
+	for _, rs := range epg.Children {
+		switch rs.Class {
+		case "fvRsProv":
+			params.Contracts.Provided = append(params.Contracts.Provided, rs.Attributes["tnVzBrCPName"])
+		case "fvRsCons":
+			params.Contracts.Consumed = append(params.Contracts.Consumed, rs.Attributes["tnVzBrCPName"])
+		case "fvRsConsIf":
+			params.Contracts.ConsumedInterfaces = append(params.Contracts.ConsumedInterfaces, rs.Attributes["tnVzCPIfName"])
+		case "fvRsProtBy":
+			if rs.Attributes["tnVzTabooName"] != "" {
+				params.Contracts.Taboo = append(params.Contracts.Taboo, rs.Attributes["tnVzTabooName"])
+			}
+		case "fvRsPathAtt":
+			params.StaticPaths = append(params.StaticPaths, v1alpha1.StaticPath{
+				Path:      rs.Attributes["tDn"],
+				Encap:     rs.Attributes["encap"],
+				Mode:      rs.Attributes["mode"],
+				Immediacy: rs.Attributes["instrImedcy"],
+			})
+		case "fvRsDomAtt":
+			params.Domains = append(params.Domains, v1alpha1.DomainAssociation{
+				Domain:              rs.Attributes["tDn"],
+				ResolutionImmediacy: rs.Attributes["resImedcy"],
+				DeploymentImmediacy: rs.Attributes["instrImedcy"],
+				PrimaryEncap:        rs.Attributes["primaryEncap"],
+				MicroSegEncap:       rs.Attributes["encap"],
+			})
+		}
+	}
+	return params
+}